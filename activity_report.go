@@ -0,0 +1,297 @@
+package transifex_api_client
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A single row of an activity report: the work done by a user for a language
+type ActivityReportRow struct {
+	User          string            `json:"user"`
+	Language      string            `json:"language"`
+	NewWords      int               `json:"new_words"`
+	EditedWords   int               `json:"edited_words"`
+	ReviewedWords int               `json:"reviewed_words"`
+	Extra         map[string]string `json:"extra,omitempty"`
+}
+
+type ActivityReportParameters struct {
+	Organization string // for the organization reports only
+	Project      string // for the project reports only
+	Resource     string // for the resource reports only
+	DateFrom     time.Time
+	DateTo       time.Time
+	Language     string
+	User         string
+}
+
+// Request an activity report of an organization.
+// The report is prepared by the service asynchronously, use WaitForActivityReport to get it.
+// https://developers.transifex.com/reference/post_organization-activity-reports-async-downloads
+func (t *TransifexApiClient) RequestOrganizationActivityReport(ctx context.Context, params ActivityReportParameters) (AsyncJob, error) {
+	if params.Organization == "" {
+		return AsyncJob{}, fmt.Errorf("mandatory parameter 'Organization' is missed")
+	}
+	return t.requestActivityReport(ctx, "organization", "organizations", params.Organization, params)
+}
+
+// Request an activity report of a project.
+// The report is prepared by the service asynchronously, use WaitForActivityReport to get it.
+// https://developers.transifex.com/reference/post_project-activity-reports-async-downloads
+func (t *TransifexApiClient) RequestProjectActivityReport(ctx context.Context, params ActivityReportParameters) (AsyncJob, error) {
+	if params.Project == "" {
+		return AsyncJob{}, fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	return t.requestActivityReport(ctx, "project", "projects", params.Project, params)
+}
+
+// Request an activity report of a resource.
+// The report is prepared by the service asynchronously, use WaitForActivityReport to get it.
+// https://developers.transifex.com/reference/post_resource-activity-reports-async-downloads
+func (t *TransifexApiClient) RequestResourceActivityReport(ctx context.Context, params ActivityReportParameters) (AsyncJob, error) {
+	if params.Resource == "" {
+		return AsyncJob{}, fmt.Errorf("mandatory parameter 'Resource' is missed")
+	}
+	return t.requestActivityReport(ctx, "resource", "resources", params.Resource, params)
+}
+
+// Wait until the requested activity report is ready, download and parse it
+func (t *TransifexApiClient) WaitForActivityReport(ctx context.Context, job AsyncJob) ([]ActivityReportRow, error) {
+	if job.ID == "" || job.Type == "" {
+		return nil, fmt.Errorf("the activity report job has no ID or type")
+	}
+
+	body, err := t.waitForAsyncDownload(ctx, "/"+job.Type, job.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return ParseActivityReportCSV(body)
+}
+
+// Request an activity report of an organization, wait until it is ready and parse it
+func (t *TransifexApiClient) GetOrganizationActivityReport(ctx context.Context, params ActivityReportParameters) ([]ActivityReportRow, error) {
+	job, err := t.RequestOrganizationActivityReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return t.WaitForActivityReport(ctx, job)
+}
+
+// Request an activity report of a project, wait until it is ready and parse it
+func (t *TransifexApiClient) GetProjectActivityReport(ctx context.Context, params ActivityReportParameters) ([]ActivityReportRow, error) {
+	job, err := t.RequestProjectActivityReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return t.WaitForActivityReport(ctx, job)
+}
+
+// Request an activity report of a resource, wait until it is ready and parse it
+func (t *TransifexApiClient) GetResourceActivityReport(ctx context.Context, params ActivityReportParameters) ([]ActivityReportRow, error) {
+	job, err := t.RequestResourceActivityReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return t.WaitForActivityReport(ctx, job)
+}
+
+// The function parses an activity report in the CSV format.
+// The columns are matched by their names in the header, the unknown columns are kept in the Extra map.
+func ParseActivityReportCSV(r io.Reader) ([]ActivityReportRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the activity report header: %s", err.Error())
+	}
+
+	// Normalize the column names
+	columns := make([]string, len(header))
+	for i, h := range header {
+		columns[i] = normalizeActivityReportColumn(h)
+	}
+
+	var rows []ActivityReportRow
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the activity report line %d: %s", line, err.Error())
+		}
+
+		var row ActivityReportRow
+		for i, v := range record {
+			if i >= len(columns) {
+				break
+			}
+			v = strings.TrimSpace(v)
+
+			switch columns[i] {
+			case "user":
+				row.User = v
+			case "language":
+				row.Language = v
+			case "new_words":
+				row.NewWords, err = parseActivityReportNumber(v)
+			case "edited_words":
+				row.EditedWords, err = parseActivityReportNumber(v)
+			case "reviewed_words":
+				row.ReviewedWords, err = parseActivityReportNumber(v)
+			default:
+				if row.Extra == nil {
+					row.Extra = map[string]string{}
+				}
+				row.Extra[header[i]] = v
+			}
+			if err != nil {
+				return nil, fmt.Errorf("activity report line %d, column '%s': %s", line, header[i], err.Error())
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// The function prints the information about an activity report row
+func (t *TransifexApiClient) PrintActivityReportRow(r ActivityReportRow, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("  User: %v\n", r.User)
+		fmt.Printf("  Language: %v\n", r.Language)
+		fmt.Printf("  NewWords: %v\n", r.NewWords)
+		fmt.Printf("  EditedWords: %v\n", r.EditedWords)
+		fmt.Printf("  ReviewedWords: %v\n", r.ReviewedWords)
+		for k, v := range r.Extra {
+			fmt.Printf("  %v: %v\n", k, v)
+		}
+
+	case "json":
+		text2print, err := json.Marshal(r)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function sends a request for an activity report of the given kind
+// ("organization", "project" or "resource")
+func (t *TransifexApiClient) requestActivityReport(ctx context.Context, kind, objType, objID string, params ActivityReportParameters) (AsyncJob, error) {
+
+	body, err := t.createActivityReportRequestBody(kind, objType, objID, params)
+	if err != nil {
+		return AsyncJob{}, err
+	}
+
+	return t.startAsyncJob(ctx, "/"+kind+"_activity_reports_async_downloads", body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createActivityReportRequestBody(kind, objType, objID string, params ActivityReportParameters) ([]byte, error) {
+	type relationship struct {
+		Data struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		} `json:"data"`
+	}
+
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				DateFrom string `json:"date_from"`
+				DateTo   string `json:"date_to,omitempty"`
+			} `json:"attributes"`
+			Relationships map[string]relationship `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = kind + "_activity_reports_async_downloads"
+	body.Data.Relationships = map[string]relationship{}
+
+	// Add the mandatory object relationship
+	var obj relationship
+	obj.Data.Type = objType
+	obj.Data.ID = objID
+	body.Data.Relationships[kind] = obj
+
+	// Add mandatory DateFrom option
+	if params.DateFrom.IsZero() {
+		return nil, fmt.Errorf("mandatory parameter 'DateFrom' is missed")
+	}
+	body.Data.Attributes.DateFrom = params.DateFrom.Format("2006-01-02")
+
+	// Add optional DateTo option
+	if !params.DateTo.IsZero() {
+		if params.DateTo.Before(params.DateFrom) {
+			return nil, fmt.Errorf("value of 'DateTo' parameter should not be before 'DateFrom'")
+		}
+		body.Data.Attributes.DateTo = params.DateTo.Format("2006-01-02")
+	}
+
+	// Add optional Language option
+	if params.Language != "" {
+		var l relationship
+		l.Data.Type = "languages"
+		l.Data.ID = params.Language
+		body.Data.Relationships["language"] = l
+	}
+
+	// Add optional User option
+	if params.User != "" {
+		var u relationship
+		u.Data.Type = "users"
+		u.Data.ID = params.User
+		body.Data.Relationships["user"] = u
+	}
+
+	return json.Marshal(body)
+}
+
+// The function maps a column name of an activity report to a field of the ActivityReportRow
+func normalizeActivityReportColumn(name string) string {
+	n := strings.ToLower(strings.TrimSpace(name))
+	n = strings.NewReplacer(" ", "_", "-", "_").Replace(n)
+
+	switch n {
+	case "user", "username", "user_name":
+		return "user"
+	case "language", "language_code", "lang":
+		return "language"
+	case "new_words", "new", "translated_words", "words_new":
+		return "new_words"
+	case "edited_words", "edited", "words_edited":
+		return "edited_words"
+	case "reviewed_words", "reviewed", "words_reviewed":
+		return "reviewed_words"
+	default:
+		return n
+	}
+}
+
+// The function converts a number of words into an int (an empty value means zero)
+func parseActivityReportNumber(v string) (int, error) {
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(strings.ReplaceAll(v, ",", ""))
+}
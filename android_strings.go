@@ -0,0 +1,450 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The kinds of the Android string resources
+const (
+	AndroidString      = "string"
+	AndroidPlurals     = "plurals"
+	AndroidStringArray = "string-array"
+)
+
+// The AndroidResource struct stores a string resource of an Android strings.xml file.
+// The values are stored without the Android escaping ("\'", "\n", etc.);
+// the values with markup (e.g. "<b>" or "<xliff:g>") are stored as raw XML.
+type AndroidResource struct {
+	Kind         string        `json:"kind"`
+	Name         string        `json:"name"`
+	Translatable bool          `json:"translatable"`
+	Comment      string        `json:"comment,omitempty"` // the XML comment preceding the resource
+	Strings      PluralStrings `json:"strings"`           // the value of a string or the items of plurals
+	Items        []string      `json:"items,omitempty"`   // the items of a string array
+	Markup       bool          `json:"markup"`
+
+	attrs []xml.Attr // the other attributes, e.g. "formatted" or "tools:ignore"
+}
+
+// The AndroidStrings struct stores the resources of an Android strings.xml file
+type AndroidStrings struct {
+	Resources     []AndroidResource
+	HeaderComment string // the comment preceding the <resources> element
+	FooterComment string // the comment following the last resource
+
+	attrs      []xml.Attr        // the attributes of the <resources> element
+	namespaces map[string]string // the prefixes of the namespaces
+}
+
+var androidResourceName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// The function parses an Android strings.xml file
+func ParseAndroidStrings(r io.Reader) (*AndroidStrings, error) {
+	a := &AndroidStrings{namespaces: map[string]string{}}
+	dec := xml.NewDecoder(r)
+
+	var comments []string
+	inResources := false
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tk := tok.(type) {
+
+		case xml.Comment:
+			comments = append(comments, strings.TrimSpace(string(tk)))
+
+		case xml.StartElement:
+			if !inResources {
+				if tk.Name.Local != "resources" {
+					return nil, fmt.Errorf("unexpected element <%s>, <resources> is expected", tk.Name.Local)
+				}
+				inResources = true
+				a.HeaderComment = strings.Join(comments, "\n")
+				comments = nil
+				for _, attr := range tk.Attr {
+					if attr.Name.Space == "xmlns" {
+						a.namespaces[attr.Value] = attr.Name.Local
+					}
+				}
+				a.attrs = tk.Attr
+				continue
+			}
+
+			res, err := decodeAndroidResource(dec, tk)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				continue
+			}
+			res.Comment = strings.Join(comments, "\n")
+			comments = nil
+			a.Resources = append(a.Resources, *res)
+
+		case xml.EndElement:
+			if tk.Name.Local == "resources" {
+				a.FooterComment = strings.Join(comments, "\n")
+				comments = nil
+			}
+		}
+	}
+
+	if !inResources {
+		return nil, fmt.Errorf("the <resources> element is missed")
+	}
+	return a, nil
+}
+
+// The function decodes a resource element; the unknown elements are skipped
+func decodeAndroidResource(dec *xml.Decoder, start xml.StartElement) (*AndroidResource, error) {
+	var el struct {
+		Attrs []xml.Attr `xml:",any,attr"`
+		Inner string     `xml:",innerxml"`
+		Items []struct {
+			Quantity string `xml:"quantity,attr"`
+			Inner    string `xml:",innerxml"`
+		} `xml:"item"`
+	}
+
+	switch start.Name.Local {
+	case AndroidString, AndroidPlurals, AndroidStringArray:
+	default:
+		return nil, dec.Skip()
+	}
+	if err := dec.DecodeElement(&el, &start); err != nil {
+		return nil, err
+	}
+
+	res := &AndroidResource{Kind: start.Name.Local, Translatable: true}
+	for _, attr := range el.Attrs {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "name":
+			res.Name = attr.Value
+		case attr.Name.Space == "" && attr.Name.Local == "translatable":
+			res.Translatable = attr.Value != "false"
+		default:
+			res.attrs = append(res.attrs, attr)
+		}
+	}
+	if res.Name == "" {
+		return nil, fmt.Errorf("mandatory attribute 'name' of <%s> is missed", res.Kind)
+	}
+
+	switch res.Kind {
+	case AndroidString:
+		res.Strings.Other, res.Markup = androidValue(el.Inner)
+	case AndroidPlurals:
+		for _, item := range el.Items {
+			if !containsString(PluralCategories, item.Quantity) {
+				return nil, fmt.Errorf("unknown 'quantity' value '%s' in <plurals name=\"%s\">", item.Quantity, res.Name)
+			}
+			v, markup := androidValue(item.Inner)
+			res.Strings.Set(item.Quantity, v)
+			res.Markup = res.Markup || markup
+		}
+	case AndroidStringArray:
+		for _, item := range el.Items {
+			v, markup := androidValue(item.Inner)
+			res.Items = append(res.Items, v)
+			res.Markup = res.Markup || markup
+		}
+	}
+
+	return res, nil
+}
+
+// The function writes the resources as an Android strings.xml file
+func (a *AndroidStrings) Write(w io.Writer) error {
+	var b bytes.Buffer
+
+	b.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n")
+	if a.HeaderComment != "" {
+		b.WriteString("<!-- " + xmlCommentText(a.HeaderComment) + " -->\n")
+	}
+	b.WriteString("<resources" + a.attrString(a.attrs) + ">\n")
+
+	for _, res := range a.Resources {
+		if res.Comment != "" {
+			b.WriteString("    <!-- " + xmlCommentText(res.Comment) + " -->\n")
+		}
+
+		attrs := " name=\"" + xmlAttrEscape(res.Name) + "\""
+		if !res.Translatable {
+			attrs += " translatable=\"false\""
+		}
+		attrs += a.attrString(res.attrs)
+
+		switch res.Kind {
+		case AndroidString:
+			b.WriteString("    <string" + attrs + ">" + androidEscape(res.Strings.Other, res.Markup) + "</string>\n")
+		case AndroidPlurals:
+			b.WriteString("    <plurals" + attrs + ">\n")
+			for _, c := range res.Strings.Categories() {
+				b.WriteString("        <item quantity=\"" + c + "\">" + androidEscape(res.Strings.Get(c), res.Markup) + "</item>\n")
+			}
+			b.WriteString("    </plurals>\n")
+		case AndroidStringArray:
+			b.WriteString("    <string-array" + attrs + ">\n")
+			for _, item := range res.Items {
+				b.WriteString("        <item>" + androidEscape(item, res.Markup) + "</item>\n")
+			}
+			b.WriteString("    </string-array>\n")
+		}
+	}
+
+	if a.FooterComment != "" {
+		b.WriteString("    <!-- " + xmlCommentText(a.FooterComment) + " -->\n")
+	}
+	b.WriteString("</resources>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// The function converts the translatable resources into resource strings.
+// The items of a string array get the keys "name[0]", "name[1]", etc.
+func (a *AndroidStrings) ResourceStrings() []ResourceString {
+	var strs []ResourceString
+	add := func(key string, p PluralStrings, pluralized bool, comment string) {
+		var s ResourceString
+		s.ID = key
+		s.Attributes.Key = key
+		s.Attributes.Strings = p
+		s.Attributes.Pluralized = pluralized
+		s.Attributes.DeveloperComment = comment
+		s.Attributes.AppearanceOrder = len(strs)
+		strs = append(strs, s)
+	}
+
+	for _, res := range a.Resources {
+		if !res.Translatable {
+			continue
+		}
+		switch res.Kind {
+		case AndroidString, AndroidPlurals:
+			add(res.Name, res.Strings, res.Kind == AndroidPlurals, res.Comment)
+		case AndroidStringArray:
+			for i, item := range res.Items {
+				add(androidArrayItemKey(res.Name, i), PluralStrings{Other: item}, false, res.Comment)
+			}
+		}
+	}
+	return strs
+}
+
+// The function returns the translated file: the translatable resources having translations
+// (by the keys returned by ResourceStrings). The non-translatable resources are not included,
+// as Android takes them from the default resources. The untranslated items of a partially
+// translated string array keep the source values.
+func (a *AndroidStrings) Translate(translations map[string]PluralStrings) *AndroidStrings {
+	t := &AndroidStrings{
+		HeaderComment: a.HeaderComment,
+		FooterComment: a.FooterComment,
+		attrs:         a.attrs,
+		namespaces:    a.namespaces,
+	}
+
+	for _, res := range a.Resources {
+		if !res.Translatable {
+			continue
+		}
+
+		switch res.Kind {
+		case AndroidString, AndroidPlurals:
+			tr, ok := translations[res.Name]
+			if !ok || len(tr.Categories()) == 0 {
+				continue
+			}
+			res.Strings = tr
+		case AndroidStringArray:
+			items := make([]string, len(res.Items))
+			translated := false
+			for i, item := range res.Items {
+				items[i] = item
+				if tr, ok := translations[androidArrayItemKey(res.Name, i)]; ok && tr.Other != "" {
+					items[i] = tr.Other
+					translated = true
+				}
+			}
+			if !translated {
+				continue
+			}
+			res.Items = items
+		}
+		t.Resources = append(t.Resources, res)
+	}
+
+	return t
+}
+
+// The function validates the resources: the names, the duplicates, the empty values
+// and the plural forms required by the language
+func (a *AndroidStrings) Validate(lang Language) []MobileStringIssue {
+	var issues []MobileStringIssue
+	seen := map[string]bool{}
+
+	for _, res := range a.Resources {
+		if !androidResourceName.MatchString(res.Name) {
+			issues = append(issues, MobileStringIssue{Key: res.Name, Reason: "invalid resource name"})
+		}
+		if seen[res.Kind+"/"+res.Name] {
+			issues = append(issues, MobileStringIssue{Key: res.Name, Reason: "duplicate " + res.Kind + " resource"})
+		}
+		seen[res.Kind+"/"+res.Name] = true
+
+		switch res.Kind {
+		case AndroidString:
+			if res.Translatable && res.Strings.Other == "" {
+				issues = append(issues, MobileStringIssue{Key: res.Name, Reason: "empty value"})
+			}
+		case AndroidPlurals:
+			issues = append(issues, checkMobilePluralForms(res.Name, res.Strings, lang)...)
+		case AndroidStringArray:
+			if len(res.Items) == 0 {
+				issues = append(issues, MobileStringIssue{Key: res.Name, Reason: "empty string array"})
+			}
+			for i, item := range res.Items {
+				if res.Translatable && item == "" {
+					issues = append(issues, MobileStringIssue{Key: androidArrayItemKey(res.Name, i), Reason: "empty value"})
+				}
+			}
+		}
+	}
+
+	return issues
+}
+
+// The function returns the key of a string array item
+func androidArrayItemKey(name string, i int) string {
+	return name + "[" + strconv.Itoa(i) + "]"
+}
+
+// The function converts the attributes into a string using the namespace prefixes of the file
+func (a *AndroidStrings) attrString(attrs []xml.Attr) string {
+	s := ""
+	for _, attr := range attrs {
+		name := attr.Name.Local
+		switch {
+		case attr.Name.Space == "xmlns":
+			name = "xmlns:" + name
+		case attr.Name.Space != "":
+			prefix, ok := a.namespaces[attr.Name.Space]
+			if !ok {
+				prefix = attr.Name.Space
+			}
+			name = prefix + ":" + name
+		}
+		s += " " + name + "=\"" + xmlAttrEscape(attr.Value) + "\""
+	}
+	return s
+}
+
+// The function converts the inner XML of a resource into its value.
+// The values with markup are kept as raw XML, the others are unescaped.
+func androidValue(inner string) (string, bool) {
+	if strings.Contains(inner, "<") {
+		return androidUnescape(inner), true
+	}
+	return androidUnescape(html.UnescapeString(inner)), false
+}
+
+// The function removes the Android escaping and the enclosing double quotes
+func androidUnescape(s string) string {
+	if len(s) >= 2 && strings.HasPrefix(s, "\"") && strings.HasSuffix(s, "\"") && !strings.HasSuffix(s, "\\\"") {
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if i+4 < len(s) {
+				if r, err := strconv.ParseUint(s[i+1:i+5], 16, 32); err == nil {
+					b.WriteRune(rune(r))
+					i += 4
+					break
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// The function applies the Android escaping to a value. The markup tags of the values
+// with markup are kept as is, the other values are escaped for XML as well.
+func androidEscape(s string, markup bool) string {
+	var b strings.Builder
+	inTag := false
+
+	for i, r := range s {
+		if markup && (inTag || r == '<') {
+			inTag = r != '>'
+			b.WriteRune(r)
+			continue
+		}
+
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '@', '?':
+			if i == 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		case '&':
+			if markup {
+				b.WriteRune(r)
+			} else {
+				b.WriteString("&amp;")
+			}
+		case '<':
+			b.WriteString("&lt;")
+		case '>':
+			b.WriteString("&gt;")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// The function escapes an XML attribute value
+func xmlAttrEscape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
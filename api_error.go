@@ -0,0 +1,56 @@
+package transifex_api_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// The ApiError struct stores an error returned by the service in the JSON:API format
+type ApiError struct {
+	StatusCode int
+	Errors     []struct {
+		Status string `json:"status"`
+		Code   string `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+		Source struct {
+			Pointer string `json:"pointer"`
+		} `json:"source"`
+	} `json:"errors"`
+}
+
+func (e *ApiError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("transifex api: unexpected response status %d", e.StatusCode)
+	}
+
+	msgs := make([]string, 0, len(e.Errors))
+	for _, v := range e.Errors {
+		msg := v.Title
+		if v.Detail != "" {
+			msg += ": " + v.Detail
+		}
+		if v.Source.Pointer != "" {
+			msg += " (" + v.Source.Pointer + ")"
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return fmt.Sprintf("transifex api: status %d: %s", e.StatusCode, strings.Join(msgs, "; "))
+}
+
+// The function creates an error from an unexpected service response
+func newApiError(resp *http.Response) error {
+	e := &ApiError{StatusCode: resp.StatusCode}
+
+	// The body is decoded on a best-effort basis, since not every error response has it
+	body, err := io.ReadAll(resp.Body)
+	if err == nil && len(body) > 0 {
+		json.Unmarshal(body, e)
+	}
+
+	return e
+}
@@ -0,0 +1,295 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The interval between two consecutive status checks of an asynchronous job
+var AsyncPollInterval = 2 * time.Second
+
+// The AsyncJob struct stores the status of an asynchronous job
+// (a file download or upload processed by the service in the background)
+type AsyncJob struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Status string `json:"status"`
+		Errors []struct {
+			Code   string `json:"code"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+		Details         json.RawMessage `json:"details"`
+		DatetimeCreated time.Time       `json:"date_created"`
+		DatetimeUpdated time.Time       `json:"date_modified"`
+	} `json:"attributes"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+// The function returns the error of a failed job
+func (j AsyncJob) err() error {
+	msgs := make([]string, 0, len(j.Attributes.Errors))
+	for _, e := range j.Attributes.Errors {
+		msgs = append(msgs, e.Code+": "+e.Detail)
+	}
+	return fmt.Errorf("asynchronous job '%s' failed: %s", j.ID, strings.Join(msgs, "; "))
+}
+
+// The function starts an asynchronous job by sending the JSON:API request body to the endpoint
+func (t *TransifexApiClient) startAsyncJob(ctx context.Context, endpoint string, body []byte) (AsyncJob, error) {
+
+	// Create an API request
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			endpoint,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return AsyncJob{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	return t.doAsyncJobRequest(req)
+}
+
+// The function sends a request, that starts an asynchronous job, and decodes the job status
+func (t *TransifexApiClient) doAsyncJobRequest(req *http.Request) (AsyncJob, error) {
+
+	// Define the variable to decode the service response
+	var j struct {
+		Data AsyncJob `json:"data"`
+	}
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return AsyncJob{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return AsyncJob{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&j)
+	if err != nil {
+		t.l.Error(err)
+		return AsyncJob{}, err
+	}
+
+	t.l.Debugf("asynchronous job '%s' of type '%s' is started", j.Data.ID, j.Data.Type)
+	return j.Data, nil
+}
+
+// The function polls the status of an asynchronous download job until the file is ready
+// and returns the body of the file. The caller is responsible for closing it.
+func (t *TransifexApiClient) waitForAsyncDownload(ctx context.Context, endpoint, id string) (io.ReadCloser, error) {
+
+	// The service redirects to the file, when it is ready.
+	// The redirect is processed manually, since the file storage does not accept the API token.
+	client := *t.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	for {
+		// Create an API request
+		req, err := http.NewRequestWithContext(
+			ctx,
+			"GET",
+			strings.Join([]string{
+				t.apiURL,
+				endpoint,
+				"/",
+				id,
+			}, ""),
+			bytes.NewBuffer(nil))
+		if err != nil {
+			t.l.Error(err)
+			return nil, err
+		}
+
+		// Set authorization and Accept HTTP request headers
+		req.Header.Set("Authorization", "Bearer "+t.token)
+		req.Header.Add("Accept", "application/vnd.api+json")
+
+		// Perform the request
+		resp, err := client.Do(req)
+		if err != nil {
+			t.l.Error(err)
+			return nil, err
+		}
+
+		switch resp.StatusCode {
+
+		// The file is ready
+		case http.StatusSeeOther, http.StatusFound:
+			resp.Body.Close()
+			return t.downloadFile(ctx, resp.Header.Get("Location"))
+
+		// The job is still in progress or failed
+		case http.StatusOK:
+			var j struct {
+				Data AsyncJob `json:"data"`
+			}
+			err = json.NewDecoder(resp.Body).Decode(&j)
+			resp.Body.Close()
+			if err != nil {
+				t.l.Error(err)
+				return nil, err
+			}
+			if j.Data.Attributes.Status == "failed" {
+				err = j.Data.err()
+				t.l.Error(err)
+				return nil, err
+			}
+			t.l.Debugf("asynchronous job '%s' status: %s", id, j.Data.Attributes.Status)
+
+		default:
+			err = newApiError(resp)
+			resp.Body.Close()
+			t.l.Error(err)
+			return nil, err
+		}
+
+		// Wait before the next check
+		if err := sleepContext(ctx, AsyncPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// The function polls the status of an asynchronous upload job until it is processed
+// and returns the final status of the job
+func (t *TransifexApiClient) waitForAsyncUpload(ctx context.Context, endpoint, id string) (AsyncJob, error) {
+	for {
+		// Define the variable to decode the service response
+		var j struct {
+			Data AsyncJob `json:"data"`
+		}
+
+		// Create an API request
+		req, err := http.NewRequestWithContext(
+			ctx,
+			"GET",
+			strings.Join([]string{
+				t.apiURL,
+				endpoint,
+				"/",
+				id,
+			}, ""),
+			bytes.NewBuffer(nil))
+		if err != nil {
+			t.l.Error(err)
+			return AsyncJob{}, err
+		}
+
+		// Set authorization and Accept HTTP request headers
+		req.Header.Set("Authorization", "Bearer "+t.token)
+		req.Header.Add("Accept", "application/vnd.api+json")
+
+		// Perform the request
+		resp, err := t.client.Do(req)
+		if err != nil {
+			t.l.Error(err)
+			return AsyncJob{}, err
+		}
+
+		// Check the response status
+		if resp.StatusCode != http.StatusOK {
+			err = newApiError(resp)
+			resp.Body.Close()
+			t.l.Error(err)
+			return AsyncJob{}, err
+		}
+
+		// Decode the JSON response into the corresponding variable
+		err = json.NewDecoder(resp.Body).Decode(&j)
+		resp.Body.Close()
+		if err != nil {
+			t.l.Error(err)
+			return AsyncJob{}, err
+		}
+
+		switch j.Data.Attributes.Status {
+		case "succeeded":
+			return j.Data, nil
+		case "failed":
+			err = j.Data.err()
+			t.l.Error(err)
+			return j.Data, err
+		}
+		t.l.Debugf("asynchronous job '%s' status: %s", id, j.Data.Attributes.Status)
+
+		// Wait before the next check
+		if err := sleepContext(ctx, AsyncPollInterval); err != nil {
+			return AsyncJob{}, err
+		}
+	}
+}
+
+// The function downloads a file prepared by an asynchronous job.
+// The caller is responsible for closing the returned body.
+func (t *TransifexApiClient) downloadFile(ctx context.Context, location string) (io.ReadCloser, error) {
+	if location == "" {
+		return nil, fmt.Errorf("the service returned an empty file location")
+	}
+
+	// Create a request (without the API token, since the link is already signed)
+	req, err := http.NewRequestWithContext(ctx, "GET", location, nil)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		err = fmt.Errorf("unable to download the file: unexpected response status %d", resp.StatusCode)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// The function waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,293 @@
+package transifex_api_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// The beginning of the messages of the issue comments raised by the character limit validation
+const characterLimitCommentPrefix = "The translation exceeds the character limit: "
+
+// The options of the character limit validation
+type CharacterLimitOptions struct {
+	ExpansionRatios       map[string]float64 // the maximum translation to source length ratios by the language codes or IDs
+	DefaultExpansionRatio float64            // the ratio of the languages not listed above, zero disables the check
+	CreateComments        bool               // raise an issue comment on the strings exceeding the character limit
+	CommentPriority       string             // the priority of the issue comments ("normal", if empty)
+}
+
+// The CharacterLimitIssue struct describes a translation exceeding the character limit
+// of the resource string or the expansion ratio of the language
+type CharacterLimitIssue struct {
+	ResourceString string     `json:"resource_string"`
+	Key            string     `json:"key"`
+	Language       string     `json:"language"`
+	Category       string     `json:"category"` // the plural form
+	Severity       QASeverity `json:"severity"` // an error for the character limit, a warning for the expansion ratio
+	Length         int        `json:"length"`
+	SourceLength   int        `json:"source_length"`
+	Limit          int        `json:"limit,omitempty"`
+	Ratio          float64    `json:"ratio,omitempty"`
+	Message        string     `json:"message"`
+}
+
+// The CharacterLimitReport struct stores the result of the character limit validation
+type CharacterLimitReport struct {
+	Resource string                  `json:"resource,omitempty"`
+	Checked  int                     `json:"checked"` // the number of the checked translations
+	Issues   []CharacterLimitIssue   `json:"issues"`
+	Comments []ResourceStringComment `json:"comments,omitempty"` // the created issue comments
+}
+
+// Validate the translations of a resource to all the project languages against
+// the character limits of the resource strings and the expansion ratios of the languages.
+// If requested, an issue comment is raised for every string and language exceeding the limit,
+// unless the string already has such an open issue.
+func (t *TransifexApiClient) ValidateCharacterLimits(ctx context.Context, resource_id string, opts CharacterLimitOptions) (CharacterLimitReport, error) {
+
+	// The resource ID includes the project ID: "o:organization:p:project:r:resource"
+	i := strings.Index(resource_id, ":r:")
+	j := strings.Index(resource_id, ":p:")
+	if i < 0 || j < 0 {
+		err := fmt.Errorf("unknown 'resource_id' value")
+		t.l.Error(err)
+		return CharacterLimitReport{}, err
+	}
+	langs, err := t.ListProjectLanguages(resource_id[:i])
+	if err != nil {
+		return CharacterLimitReport{}, err
+	}
+
+	// Collect the resource strings page by page
+	var strs []ResourceString
+	sp := GetResourceStringsCollectionParameters{Resource: resource_id}
+	for {
+		page, next, err := t.getResourceStringsCollectionPage(ctx, sp)
+		if err != nil {
+			return CharacterLimitReport{}, err
+		}
+		strs = append(strs, page...)
+
+		if next == "" {
+			break
+		}
+		sp.Cursor = next
+	}
+
+	// Collect the translations to every language page by page
+	var trs []ResourceTranslation
+	for _, l := range langs {
+		tp := GetResourceTranslationsCollectionParameters{Resource: resource_id, Language: l.ID}
+		for {
+			page, next, err := t.getResourceTranslationsCollectionPage(ctx, tp)
+			if err != nil {
+				return CharacterLimitReport{}, err
+			}
+			trs = append(trs, page...)
+
+			if next == "" {
+				break
+			}
+			tp.Cursor = next
+		}
+	}
+	t.l.Debugf("%d strings and %d translations of resource '%s' were received", len(strs), len(trs), resource_id)
+
+	report := CheckCharacterLimits(strs, trs, opts)
+	report.Resource = resource_id
+
+	if !opts.CreateComments {
+		return report, nil
+	}
+
+	priority := opts.CommentPriority
+	if priority == "" {
+		priority = "normal"
+	}
+
+	// Skip the strings already having an open character limit issue (e.g. raised by the previous run)
+	commented := map[string]bool{}
+	cp := ListResourceStringCommentsParameters{
+		Organization: resource_id[:j],
+		Resource:     resource_id,
+		Type:         "issue",
+		Status:       "open",
+	}
+	for {
+		page, next, err := t.listResourceStringCommentsPage(ctx, cp)
+		if err != nil {
+			return report, err
+		}
+		for _, c := range page {
+			if strings.HasPrefix(c.Attributes.Message, characterLimitCommentPrefix) {
+				commented[c.Relationships.ResourceString.Data.ID+"/"+c.Relationships.Language.Data.ID] = true
+			}
+		}
+
+		if next == "" {
+			break
+		}
+		cp.Cursor = next
+	}
+
+	// Raise a single issue per string and language
+	for _, issue := range report.Issues {
+		if issue.Severity != QAError || commented[issue.ResourceString+"/"+issue.Language] {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		commented[issue.ResourceString+"/"+issue.Language] = true
+
+		c, err := t.CreateResourceStringComment(CreateResourceStringCommentParameters{
+			ResourceString: issue.ResourceString,
+			Language:       issue.Language,
+			Message:        characterLimitCommentPrefix + issue.Message,
+			Type:           "issue",
+			Priority:       priority,
+		})
+		if err != nil {
+			return report, err
+		}
+		report.Comments = append(report.Comments, c)
+	}
+
+	return report, nil
+}
+
+// The function checks the lengths of the translations (in approximate grapheme clusters) against
+// the character limits of the resource strings and the expansion ratios of the languages.
+// Each plural form of a translation is compared with the same form of the source string
+// (or with its "other" form, if the source string has no such form).
+func CheckCharacterLimits(strs []ResourceString, trs []ResourceTranslation, opts CharacterLimitOptions) CharacterLimitReport {
+	var report CharacterLimitReport
+
+	byID := map[string]*ResourceString{}
+	for i := range strs {
+		byID[strs[i].ID] = &strs[i]
+	}
+
+	for _, tr := range trs {
+		s, ok := byID[tr.Relationships.ResourceString.Data.ID]
+		if !ok || len(tr.Attributes.Strings.Categories()) == 0 {
+			continue
+		}
+		report.Checked++
+
+		language := tr.Relationships.Language.Data.ID
+		ratio := opts.expansionRatio(language)
+		limit := s.Attributes.CharacterLimit
+
+		for _, c := range tr.Attributes.Strings.Categories() {
+			source := s.Attributes.Strings.Get(c)
+			if source == "" {
+				source = s.Attributes.Strings.Other
+			}
+			issue := CharacterLimitIssue{
+				ResourceString: s.ID,
+				Key:            s.Attributes.Key,
+				Language:       language,
+				Category:       c,
+				Length:         ApproximateGraphemeCount(tr.Attributes.Strings.Get(c)),
+				SourceLength:   ApproximateGraphemeCount(source),
+			}
+
+			if limit > 0 && issue.Length > limit {
+				issue.Severity = QAError
+				issue.Limit = limit
+				issue.Message = fmt.Sprintf("the translation has %d characters, the limit is %d", issue.Length, limit)
+				report.Issues = append(report.Issues, issue)
+				continue
+			}
+
+			if ratio > 0 && issue.SourceLength > 0 && float64(issue.Length) > ratio*float64(issue.SourceLength) {
+				issue.Severity = QAWarning
+				issue.Ratio = float64(issue.Length) / float64(issue.SourceLength)
+				issue.Message = fmt.Sprintf("the translation is %.2f times longer than the source, the expected ratio is %.2f at most", issue.Ratio, ratio)
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+
+	return report
+}
+
+// The function prints the character limit validation report
+func (t *TransifexApiClient) PrintCharacterLimitReport(r CharacterLimitReport, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Character limit report:\n")
+		fmt.Printf("  Resource: %v\n", r.Resource)
+		fmt.Printf("  Checked: %v\n", r.Checked)
+		fmt.Printf("  Issues:\n")
+		for _, i := range r.Issues {
+			fmt.Printf("    [%v] %v (%v, %v): %v\n", i.Severity, i.Key, i.Language, i.Category, i.Message)
+		}
+		fmt.Printf("  Comments: %v\n", len(r.Comments))
+
+	case "json":
+		text2print, err := json.Marshal(r)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function returns the expansion ratio of a language by its ID ("l:uk") or code ("uk")
+func (o CharacterLimitOptions) expansionRatio(language string) float64 {
+	if r, ok := o.ExpansionRatios[language]; ok {
+		return r
+	}
+	if r, ok := o.ExpansionRatios[strings.TrimPrefix(language, "l:")]; ok {
+		return r
+	}
+	return o.DefaultExpansionRatio
+}
+
+// The function returns the approximate number of the user-perceived characters
+// (extended grapheme clusters) of the text. The combining marks, the variation selectors,
+// the emoji modifiers and the ZWJ sequences, the pairs of the regional indicators (flags),
+// the Hangul vowel and final jamo and the CR LF pairs do not start a new cluster.
+// It is not the full Unicode segmentation (UAX #29): e.g. the Indic conjuncts are not joined,
+// so "क्षि" is counted as 2 characters instead of 1.
+func ApproximateGraphemeCount(s string) int {
+	n := 0
+	var prev rune
+	regionalPair := false
+
+	for i, r := range s {
+		extend := i > 0 && (r == '\n' && prev == '\r' ||
+			prev == '\u200d' ||
+			unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+			r == '\u200c' || r == '\u200d' ||
+			r >= 0xFE00 && r <= 0xFE0F || r >= 0xE0100 && r <= 0xE01EF || // variation selectors
+			r >= 0x1F3FB && r <= 0x1F3FF || // emoji modifiers
+			r >= 0xE0020 && r <= 0xE007F || // tags
+			r >= 0x1160 && r <= 0x11FF || r >= 0xD7B0 && r <= 0xD7FF) // Hangul jamo
+
+		// The regional indicators are combined in pairs
+		if r >= 0x1F1E6 && r <= 0x1F1FF {
+			extend = regionalPair
+			regionalPair = !regionalPair
+		} else {
+			regionalPair = false
+		}
+
+		if !extend {
+			n++
+		}
+		prev = r
+	}
+	return n
+}
@@ -0,0 +1,29 @@
+package transifex_api_client
+
+import "testing"
+
+func TestApproximateGraphemeCount(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text string
+		n    int
+	}{
+		{"empty", "", 0},
+		{"ascii", "Save", 4},
+		{"cyrillic", "Зберегти", 8},
+		{"combining marks", "e\u0301te\u0301", 3},
+		{"emoji ZWJ sequence", "\U0001F468\u200d\U0001F469\u200d\U0001F467", 1},
+		{"emoji modifier", "\U0001F44D\U0001F3FD!", 2},
+		{"variation selector", "\u2764\ufe0f", 1},
+		{"flags", "\U0001F1FA\U0001F1E6\U0001F1EC\U0001F1E7", 2},
+		{"odd regional indicators", "\U0001F1FA\U0001F1E6\U0001F1EC", 2},
+		{"Hangul jamo", "\u1112\u1161\u11ab\u1100\u1173\u11af", 2},
+		{"Hangul syllables", "\ud55c\uae00", 2},
+		{"CRLF", "a\r\nb", 3},
+		{"Indic conjunct (approximation)", "\u0915\u094d\u0937\u093f", 2},
+	} {
+		if got := ApproximateGraphemeCount(tc.text); got != tc.n {
+			t.Errorf("%s: ApproximateGraphemeCount(%q) = %d, expected %d", tc.name, tc.text, got, tc.n)
+		}
+	}
+}
@@ -0,0 +1,658 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+type ContextScreenshot struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name             string    `json:"name"`
+		MediaURL         string    `json:"media_url"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		Project struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"project"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ContextScreenshotMap struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		CoordinateX     int       `json:"coordinate_x"`
+		CoordinateY     int       `json:"coordinate_y"`
+		Width           int       `json:"width"`
+		Height          int       `json:"height"`
+		DatetimeCreated time.Time `json:"datetime_created"`
+	} `json:"attributes"`
+	Relationships struct {
+		ContextScreenshot struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"context_screenshot"`
+		ResourceString struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"resource_string"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ListContextScreenshotsParameters struct {
+	Project string
+	Name    string
+	Cursor  string
+}
+
+type ListContextScreenshotMapsParameters struct {
+	Project           string
+	ContextScreenshot string
+	ResourceString    string
+	Cursor            string
+}
+
+type CreateContextScreenshotMapParameters struct {
+	ContextScreenshot string
+	ResourceString    string
+	CoordinateX       int
+	CoordinateY       int
+	Width             int
+	Height            int
+}
+
+// Get the list of context screenshots of a project.
+// https://developers.transifex.com/reference/get_context-screenshots
+func (t *TransifexApiClient) ListContextScreenshots(params ListContextScreenshotsParameters) ([]ContextScreenshot, error) {
+
+	paramStr, err := t.createListContextScreenshotsParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var css struct {
+		Data  []ContextScreenshot `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshots",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&css)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return css.Data, nil
+}
+
+// Upload a context screenshot (a PNG or JPEG image) into a project.
+// https://developers.transifex.com/reference/post_context-screenshots
+func (t *TransifexApiClient) UploadContextScreenshot(project, name string, image io.Reader) (ContextScreenshot, error) {
+
+	// Check the mandatory parameters
+	if project == "" {
+		return ContextScreenshot{}, fmt.Errorf("mandatory parameter 'project' is missed")
+	}
+	if name == "" {
+		return ContextScreenshot{}, fmt.Errorf("mandatory parameter 'name' is missed")
+	}
+	if image == nil {
+		return ContextScreenshot{}, fmt.Errorf("mandatory parameter 'image' is missed")
+	}
+
+	// Stream the multipart form with the image, so that it is not buffered in memory
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := func() error {
+			if err := mw.WriteField("project", project); err != nil {
+				return err
+			}
+			if err := mw.WriteField("name", name); err != nil {
+				return err
+			}
+			fw, err := mw.CreateFormFile("content", path.Base(name))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(fw, image); err != nil {
+				return err
+			}
+			return mw.Close()
+		}()
+		pw.CloseWithError(err)
+	}()
+
+	// Define the variable to decode the service response
+	var cs struct {
+		Data ContextScreenshot `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshots",
+		}, ""),
+		pr)
+	if err != nil {
+		pr.Close()
+		t.l.Error(err)
+		return ContextScreenshot{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", mw.FormDataContentType())
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ContextScreenshot{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ContextScreenshot{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&cs)
+	if err != nil {
+		t.l.Error(err)
+		return ContextScreenshot{}, err
+	}
+
+	return cs.Data, nil
+}
+
+// Delete a context screenshot together with its mappings.
+// https://developers.transifex.com/reference/delete_context-screenshots-context-screenshot-id
+func (t *TransifexApiClient) DeleteContextScreenshot(context_screenshot_id string) error {
+
+	// Check the mandatory parameter
+	if context_screenshot_id == "" {
+		return fmt.Errorf("mandatory parameter 'context_screenshot_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshots/",
+			context_screenshot_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Get the list of mappings between context screenshots and resource strings.
+// https://developers.transifex.com/reference/get_context-screenshot-maps
+func (t *TransifexApiClient) ListContextScreenshotMaps(params ListContextScreenshotMapsParameters) ([]ContextScreenshotMap, error) {
+
+	paramStr, err := t.createListContextScreenshotMapsParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var csms struct {
+		Data  []ContextScreenshotMap `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshot_maps",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&csms)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return csms.Data, nil
+}
+
+// Map a resource string to an area of a context screenshot.
+// https://developers.transifex.com/reference/post_context-screenshot-maps
+func (t *TransifexApiClient) CreateContextScreenshotMap(params CreateContextScreenshotMapParameters) (ContextScreenshotMap, error) {
+
+	body, err := t.createCreateContextScreenshotMapRequestBody(params)
+	if err != nil {
+		return ContextScreenshotMap{}, err
+	}
+
+	// Define the variable to decode the service response
+	var csm struct {
+		Data ContextScreenshotMap `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshot_maps",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return ContextScreenshotMap{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ContextScreenshotMap{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ContextScreenshotMap{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&csm)
+	if err != nil {
+		t.l.Error(err)
+		return ContextScreenshotMap{}, err
+	}
+
+	return csm.Data, nil
+}
+
+// Delete a mapping between a context screenshot and a resource string.
+// https://developers.transifex.com/reference/delete_context-screenshot-maps-context-screenshot-map-id
+func (t *TransifexApiClient) DeleteContextScreenshotMap(context_screenshot_map_id string) error {
+
+	// Check the mandatory parameter
+	if context_screenshot_map_id == "" {
+		return fmt.Errorf("mandatory parameter 'context_screenshot_map_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/context_screenshot_maps/",
+			context_screenshot_map_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// The function prints the information about a context screenshot
+func (t *TransifexApiClient) PrintContextScreenshot(c ContextScreenshot, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Context screenshot information:\n")
+		fmt.Printf("  ID: %v\n", c.ID)
+		fmt.Printf("  Type: %v\n", c.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Name: %v\n", c.Attributes.Name)
+		fmt.Printf("    MediaURL: %v\n", c.Attributes.MediaURL)
+		fmt.Printf("    DatetimeCreated: %v\n", c.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", c.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    Project:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", c.Relationships.Project.Data.Type)
+		fmt.Printf("        ID: %v\n", c.Relationships.Project.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", c.Relationships.Project.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", c.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(c)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function prints the information about a context screenshot map
+func (t *TransifexApiClient) PrintContextScreenshotMap(c ContextScreenshotMap, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Context screenshot map information:\n")
+		fmt.Printf("  ID: %v\n", c.ID)
+		fmt.Printf("  Type: %v\n", c.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    CoordinateX: %v\n", c.Attributes.CoordinateX)
+		fmt.Printf("    CoordinateY: %v\n", c.Attributes.CoordinateY)
+		fmt.Printf("    Width: %v\n", c.Attributes.Width)
+		fmt.Printf("    Height: %v\n", c.Attributes.Height)
+		fmt.Printf("    DatetimeCreated: %v\n", c.Attributes.DatetimeCreated)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    ContextScreenshot:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", c.Relationships.ContextScreenshot.Data.Type)
+		fmt.Printf("        ID: %v\n", c.Relationships.ContextScreenshot.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", c.Relationships.ContextScreenshot.Links.Related)
+		fmt.Printf("    ResourceString:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", c.Relationships.ResourceString.Data.Type)
+		fmt.Printf("        ID: %v\n", c.Relationships.ResourceString.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", c.Relationships.ResourceString.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", c.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(c)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListContextScreenshotsParametersString(params ListContextScreenshotsParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Project option
+	if params.Project == "" {
+		return "", fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	paramStr += "&filter[project]=" + params.Project
+
+	// Add optional Name value
+	if params.Name != "" {
+		paramStr += "&filter[name]=" + params.Name
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListContextScreenshotMapsParametersString(params ListContextScreenshotMapsParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Project option
+	if params.Project == "" {
+		return "", fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	paramStr += "&filter[project]=" + params.Project
+
+	// Add optional ContextScreenshot value
+	if params.ContextScreenshot != "" {
+		paramStr += "&filter[context_screenshot]=" + params.ContextScreenshot
+	}
+
+	// Add optional ResourceString value
+	if params.ResourceString != "" {
+		paramStr += "&filter[resource_string]=" + params.ResourceString
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateContextScreenshotMapRequestBody(params CreateContextScreenshotMapParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				CoordinateX int `json:"coordinate_x"`
+				CoordinateY int `json:"coordinate_y"`
+				Width       int `json:"width"`
+				Height      int `json:"height"`
+			} `json:"attributes"`
+			Relationships struct {
+				ContextScreenshot struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"context_screenshot"`
+				ResourceString struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"resource_string"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "context_screenshot_maps"
+
+	// Add mandatory ContextScreenshot option
+	if params.ContextScreenshot == "" {
+		return nil, fmt.Errorf("mandatory parameter 'ContextScreenshot' is missed")
+	}
+	body.Data.Relationships.ContextScreenshot.Data.Type = "context_screenshots"
+	body.Data.Relationships.ContextScreenshot.Data.ID = params.ContextScreenshot
+
+	// Add mandatory ResourceString option
+	if params.ResourceString == "" {
+		return nil, fmt.Errorf("mandatory parameter 'ResourceString' is missed")
+	}
+	body.Data.Relationships.ResourceString.Data.Type = "resource_strings"
+	body.Data.Relationships.ResourceString.Data.ID = params.ResourceString
+
+	// Check the area of the screenshot
+	if params.CoordinateX < 0 || params.CoordinateY < 0 {
+		return nil, fmt.Errorf("values of 'CoordinateX' and 'CoordinateY' parameters should not be negative")
+	}
+	if params.Width <= 0 || params.Height <= 0 {
+		return nil, fmt.Errorf("values of 'Width' and 'Height' parameters should be positive")
+	}
+	body.Data.Attributes.CoordinateX = params.CoordinateX
+	body.Data.Attributes.CoordinateY = params.CoordinateY
+	body.Data.Attributes.Width = params.Width
+	body.Data.Attributes.Height = params.Height
+
+	return json.Marshal(body)
+}
@@ -0,0 +1,1190 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Glossary struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name             string    `json:"name"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		Organization struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"organization"`
+		SourceLanguage struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"source_language"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type GlossaryTerm struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Term             string    `json:"term"`
+		PartOfSpeech     string    `json:"pos"`
+		Comment          string    `json:"comment"`
+		CaseSensitive    bool      `json:"case_sensitive"`
+		Translatable     bool      `json:"translatable"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		Glossary struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"glossary"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type GlossaryTermTranslation struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Translation      string    `json:"translation"`
+		Comment          string    `json:"comment"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		GlossaryTerm struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"glossary_term"`
+		Language struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"language"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ListGlossariesParameters struct {
+	Organization string
+	Cursor       string
+}
+
+type ListGlossaryTermsParameters struct {
+	Glossary string
+	Term     string
+	Cursor   string
+}
+
+type CreateGlossaryTermParameters struct {
+	Glossary      string
+	Term          string
+	PartOfSpeech  string
+	Comment       string
+	CaseSensitive string
+	Translatable  string
+}
+
+type UpdateGlossaryTermParameters struct {
+	GlossaryTerm  string
+	Term          string
+	PartOfSpeech  string
+	Comment       string
+	CaseSensitive string
+	Translatable  string
+}
+
+type ListGlossaryTermTranslationsParameters struct {
+	Glossary     string
+	GlossaryTerm string
+	Language     string
+	Cursor       string
+}
+
+type CreateGlossaryTermTranslationParameters struct {
+	GlossaryTerm string
+	Language     string
+	Translation  string
+	Comment      string
+}
+
+type UpdateGlossaryTermTranslationParameters struct {
+	GlossaryTermTranslation string
+	Translation             string
+	Comment                 string
+}
+
+// Get the list of glossaries that belong to an organization.
+// https://developers.transifex.com/reference/get_glossaries
+func (t *TransifexApiClient) ListGlossaries(params ListGlossariesParameters) ([]Glossary, error) {
+
+	paramStr, err := t.createListGlossariesParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var gs struct {
+		Data  []Glossary `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossaries",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gs)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return gs.Data, nil
+}
+
+// Get the details of a glossary.
+// https://developers.transifex.com/reference/get_glossaries-glossary-id
+func (t *TransifexApiClient) GetGlossaryDetails(glossary_id string) (Glossary, error) {
+
+	// Define the variable to decode the service response
+	var gd struct {
+		Data Glossary `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossaries/",
+			glossary_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return Glossary{}, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Glossary{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Glossary{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gd)
+	if err != nil {
+		t.l.Error(err)
+		return Glossary{}, err
+	}
+
+	return gd.Data, nil
+}
+
+// Get the list of terms of a glossary.
+// https://developers.transifex.com/reference/get_glossary-terms
+func (t *TransifexApiClient) ListGlossaryTerms(params ListGlossaryTermsParameters) ([]GlossaryTerm, error) {
+
+	paramStr, err := t.createListGlossaryTermsParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var gts struct {
+		Data  []GlossaryTerm `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_terms",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gts)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return gts.Data, nil
+}
+
+// Create a new term in a glossary.
+// https://developers.transifex.com/reference/post_glossary-terms
+func (t *TransifexApiClient) CreateGlossaryTerm(params CreateGlossaryTermParameters) (GlossaryTerm, error) {
+
+	body, err := t.createCreateGlossaryTermRequestBody(params)
+	if err != nil {
+		return GlossaryTerm{}, err
+	}
+
+	// Define the variable to decode the service response
+	var gt struct {
+		Data GlossaryTerm `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_terms",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gt)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	return gt.Data, nil
+}
+
+// Update a glossary term.
+// Only the parameters with non-empty values are updated.
+// https://developers.transifex.com/reference/patch_glossary-terms-glossary-term-id
+func (t *TransifexApiClient) UpdateGlossaryTerm(params UpdateGlossaryTermParameters) (GlossaryTerm, error) {
+
+	body, err := t.createUpdateGlossaryTermRequestBody(params)
+	if err != nil {
+		return GlossaryTerm{}, err
+	}
+
+	// Define the variable to decode the service response
+	var gt struct {
+		Data GlossaryTerm `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_terms/",
+			params.GlossaryTerm,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gt)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTerm{}, err
+	}
+
+	return gt.Data, nil
+}
+
+// Delete a glossary term together with its translations.
+// https://developers.transifex.com/reference/delete_glossary-terms-glossary-term-id
+func (t *TransifexApiClient) DeleteGlossaryTerm(glossary_term_id string) error {
+
+	// Check the mandatory parameter
+	if glossary_term_id == "" {
+		return fmt.Errorf("mandatory parameter 'glossary_term_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_terms/",
+			glossary_term_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Get the list of translations of glossary terms.
+// https://developers.transifex.com/reference/get_glossary-term-translations
+func (t *TransifexApiClient) ListGlossaryTermTranslations(params ListGlossaryTermTranslationsParameters) ([]GlossaryTermTranslation, error) {
+
+	paramStr, err := t.createListGlossaryTermTranslationsParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var gtts struct {
+		Data  []GlossaryTermTranslation `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_term_translations",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gtts)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return gtts.Data, nil
+}
+
+// Create a translation of a glossary term.
+// https://developers.transifex.com/reference/post_glossary-term-translations
+func (t *TransifexApiClient) CreateGlossaryTermTranslation(params CreateGlossaryTermTranslationParameters) (GlossaryTermTranslation, error) {
+
+	body, err := t.createCreateGlossaryTermTranslationRequestBody(params)
+	if err != nil {
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Define the variable to decode the service response
+	var gtt struct {
+		Data GlossaryTermTranslation `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_term_translations",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gtt)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	return gtt.Data, nil
+}
+
+// Update a translation of a glossary term.
+// Only the parameters with non-empty values are updated.
+// https://developers.transifex.com/reference/patch_glossary-term-translations-glossary-term-translation-id
+func (t *TransifexApiClient) UpdateGlossaryTermTranslation(params UpdateGlossaryTermTranslationParameters) (GlossaryTermTranslation, error) {
+
+	body, err := t.createUpdateGlossaryTermTranslationRequestBody(params)
+	if err != nil {
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Define the variable to decode the service response
+	var gtt struct {
+		Data GlossaryTermTranslation `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_term_translations/",
+			params.GlossaryTermTranslation,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&gtt)
+	if err != nil {
+		t.l.Error(err)
+		return GlossaryTermTranslation{}, err
+	}
+
+	return gtt.Data, nil
+}
+
+// Delete a translation of a glossary term.
+// https://developers.transifex.com/reference/delete_glossary-term-translations-glossary-term-translation-id
+func (t *TransifexApiClient) DeleteGlossaryTermTranslation(glossary_term_translation_id string) error {
+
+	// Check the mandatory parameter
+	if glossary_term_translation_id == "" {
+		return fmt.Errorf("mandatory parameter 'glossary_term_translation_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossary_term_translations/",
+			glossary_term_translation_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Import glossary terms and their translations from a CSV file.
+// The file is processed by the service asynchronously, the function waits until it is done.
+// The CSV file should have the columns "term", "pos", "comment" and a pair
+// of "translation_<language code>" and "comment_<language code>" columns for every language.
+// https://developers.transifex.com/reference/post_glossaries-async-uploads
+func (t *TransifexApiClient) ImportGlossaryCSV(ctx context.Context, glossary string, r io.Reader) (AsyncJob, error) {
+
+	// Check the mandatory parameters
+	if glossary == "" {
+		return AsyncJob{}, fmt.Errorf("mandatory parameter 'glossary' is missed")
+	}
+	if r == nil {
+		return AsyncJob{}, fmt.Errorf("mandatory parameter 'r' is missed")
+	}
+
+	// Stream the multipart form with the file, so that it is not buffered in memory
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := func() error {
+			if err := mw.WriteField("glossary", glossary); err != nil {
+				return err
+			}
+			fw, err := mw.CreateFormFile("content", "glossary.csv")
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(fw, r); err != nil {
+				return err
+			}
+			return mw.Close()
+		}()
+		pw.CloseWithError(err)
+	}()
+
+	// Create an API request
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/glossaries_async_uploads",
+		}, ""),
+		pr)
+	if err != nil {
+		pr.Close()
+		t.l.Error(err)
+		return AsyncJob{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", mw.FormDataContentType())
+
+	// Start the CSV file processing
+	job, err := t.doAsyncJobRequest(req)
+	if err != nil {
+		return AsyncJob{}, err
+	}
+
+	// Wait until the file is processed
+	return t.waitForAsyncUpload(ctx, "/glossaries_async_uploads", job.ID)
+}
+
+// The function prints the information about a glossary
+func (t *TransifexApiClient) PrintGlossary(g Glossary, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Glossary information:\n")
+		fmt.Printf("  ID: %v\n", g.ID)
+		fmt.Printf("  Type: %v\n", g.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Name: %v\n", g.Attributes.Name)
+		fmt.Printf("    DatetimeCreated: %v\n", g.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", g.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    Organization:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", g.Relationships.Organization.Data.Type)
+		fmt.Printf("        ID: %v\n", g.Relationships.Organization.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", g.Relationships.Organization.Links.Related)
+		fmt.Printf("    SourceLanguage:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", g.Relationships.SourceLanguage.Data.Type)
+		fmt.Printf("        ID: %v\n", g.Relationships.SourceLanguage.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", g.Relationships.SourceLanguage.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", g.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(g)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function prints the information about a glossary term
+func (t *TransifexApiClient) PrintGlossaryTerm(g GlossaryTerm, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Glossary term information:\n")
+		fmt.Printf("  ID: %v\n", g.ID)
+		fmt.Printf("  Type: %v\n", g.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Term: %v\n", g.Attributes.Term)
+		fmt.Printf("    PartOfSpeech: %v\n", g.Attributes.PartOfSpeech)
+		fmt.Printf("    Comment: %v\n", g.Attributes.Comment)
+		fmt.Printf("    CaseSensitive: %v\n", g.Attributes.CaseSensitive)
+		fmt.Printf("    Translatable: %v\n", g.Attributes.Translatable)
+		fmt.Printf("    DatetimeCreated: %v\n", g.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", g.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    Glossary:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", g.Relationships.Glossary.Data.Type)
+		fmt.Printf("        ID: %v\n", g.Relationships.Glossary.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", g.Relationships.Glossary.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", g.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(g)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function prints the information about a glossary term translation
+func (t *TransifexApiClient) PrintGlossaryTermTranslation(g GlossaryTermTranslation, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Glossary term translation information:\n")
+		fmt.Printf("  ID: %v\n", g.ID)
+		fmt.Printf("  Type: %v\n", g.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Translation: %v\n", g.Attributes.Translation)
+		fmt.Printf("    Comment: %v\n", g.Attributes.Comment)
+		fmt.Printf("    DatetimeCreated: %v\n", g.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", g.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    GlossaryTerm:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", g.Relationships.GlossaryTerm.Data.Type)
+		fmt.Printf("        ID: %v\n", g.Relationships.GlossaryTerm.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", g.Relationships.GlossaryTerm.Links.Related)
+		fmt.Printf("    Language:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", g.Relationships.Language.Data.Type)
+		fmt.Printf("        ID: %v\n", g.Relationships.Language.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", g.Relationships.Language.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", g.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(g)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListGlossariesParametersString(params ListGlossariesParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Organization option
+	if params.Organization == "" {
+		return "", fmt.Errorf("mandatory parameter 'Organization' is missed")
+	}
+	paramStr += "&filter[organization]=" + params.Organization
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListGlossaryTermsParametersString(params ListGlossaryTermsParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Glossary option
+	if params.Glossary == "" {
+		return "", fmt.Errorf("mandatory parameter 'Glossary' is missed")
+	}
+	paramStr += "&filter[glossary]=" + params.Glossary
+
+	// Add optional Term value
+	if params.Term != "" {
+		paramStr += "&filter[term]=" + params.Term
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListGlossaryTermTranslationsParametersString(params ListGlossaryTermTranslationsParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Either Glossary or GlossaryTerm option is mandatory
+	if params.Glossary == "" && params.GlossaryTerm == "" {
+		return "", fmt.Errorf("one of the parameters 'Glossary' or 'GlossaryTerm' should be set")
+	}
+
+	// Add optional Glossary value
+	if params.Glossary != "" {
+		paramStr += "&filter[glossary]=" + params.Glossary
+	}
+
+	// Add optional GlossaryTerm value
+	if params.GlossaryTerm != "" {
+		paramStr += "&filter[glossary_term]=" + params.GlossaryTerm
+	}
+
+	// Add optional Language value
+	if params.Language != "" {
+		paramStr += "&filter[language]=" + params.Language
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateGlossaryTermRequestBody(params CreateGlossaryTermParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Term          string `json:"term"`
+				PartOfSpeech  string `json:"pos,omitempty"`
+				Comment       string `json:"comment,omitempty"`
+				CaseSensitive *bool  `json:"case_sensitive,omitempty"`
+				Translatable  *bool  `json:"translatable,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				Glossary struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"glossary"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "glossary_terms"
+
+	// Add mandatory Glossary option
+	if params.Glossary == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Glossary' is missed")
+	}
+	body.Data.Relationships.Glossary.Data.Type = "glossaries"
+	body.Data.Relationships.Glossary.Data.ID = params.Glossary
+
+	// Add mandatory Term option
+	if params.Term == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Term' is missed")
+	}
+	body.Data.Attributes.Term = params.Term
+
+	// Add optional PartOfSpeech and Comment values
+	body.Data.Attributes.PartOfSpeech = params.PartOfSpeech
+	body.Data.Attributes.Comment = params.Comment
+
+	// Add allowed CaseSensitive value
+	caseSensitive, err := parseOptionalBool(params.CaseSensitive, "CaseSensitive")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.CaseSensitive = caseSensitive
+
+	// Add allowed Translatable value
+	translatable, err := parseOptionalBool(params.Translatable, "Translatable")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Translatable = translatable
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateGlossaryTermRequestBody(params UpdateGlossaryTermParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Term          string `json:"term,omitempty"`
+				PartOfSpeech  string `json:"pos,omitempty"`
+				Comment       string `json:"comment,omitempty"`
+				CaseSensitive *bool  `json:"case_sensitive,omitempty"`
+				Translatable  *bool  `json:"translatable,omitempty"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "glossary_terms"
+
+	// Add mandatory GlossaryTerm option
+	if params.GlossaryTerm == "" {
+		return nil, fmt.Errorf("mandatory parameter 'GlossaryTerm' is missed")
+	}
+	body.Data.ID = params.GlossaryTerm
+
+	// Add optional Term, PartOfSpeech and Comment values
+	body.Data.Attributes.Term = params.Term
+	body.Data.Attributes.PartOfSpeech = params.PartOfSpeech
+	body.Data.Attributes.Comment = params.Comment
+
+	// Add allowed CaseSensitive value
+	caseSensitive, err := parseOptionalBool(params.CaseSensitive, "CaseSensitive")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.CaseSensitive = caseSensitive
+
+	// Add allowed Translatable value
+	translatable, err := parseOptionalBool(params.Translatable, "Translatable")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Translatable = translatable
+
+	// At least one attribute should be updated
+	if params.Term == "" && params.PartOfSpeech == "" && params.Comment == "" && caseSensitive == nil && translatable == nil {
+		return nil, fmt.Errorf("at least one of the glossary term attributes should be set")
+	}
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateGlossaryTermTranslationRequestBody(params CreateGlossaryTermTranslationParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Translation string `json:"translation"`
+				Comment     string `json:"comment,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				GlossaryTerm struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"glossary_term"`
+				Language struct {
+					Data LanguageRelationship `json:"data"`
+				} `json:"language"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "glossary_term_translations"
+
+	// Add mandatory GlossaryTerm option
+	if params.GlossaryTerm == "" {
+		return nil, fmt.Errorf("mandatory parameter 'GlossaryTerm' is missed")
+	}
+	body.Data.Relationships.GlossaryTerm.Data.Type = "glossary_terms"
+	body.Data.Relationships.GlossaryTerm.Data.ID = params.GlossaryTerm
+
+	// Add mandatory Language option
+	if params.Language == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Language' is missed")
+	}
+	body.Data.Relationships.Language.Data.Type = "languages"
+	body.Data.Relationships.Language.Data.ID = params.Language
+
+	// Add mandatory Translation option
+	if params.Translation == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Translation' is missed")
+	}
+	body.Data.Attributes.Translation = params.Translation
+
+	// Add optional Comment value
+	body.Data.Attributes.Comment = params.Comment
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateGlossaryTermTranslationRequestBody(params UpdateGlossaryTermTranslationParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Translation string `json:"translation,omitempty"`
+				Comment     string `json:"comment,omitempty"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "glossary_term_translations"
+
+	// Add mandatory GlossaryTermTranslation option
+	if params.GlossaryTermTranslation == "" {
+		return nil, fmt.Errorf("mandatory parameter 'GlossaryTermTranslation' is missed")
+	}
+	body.Data.ID = params.GlossaryTermTranslation
+
+	// At least one attribute should be updated
+	if params.Translation == "" && params.Comment == "" {
+		return nil, fmt.Errorf("at least one of the parameters 'Translation' or 'Comment' should be set")
+	}
+	body.Data.Attributes.Translation = params.Translation
+	body.Data.Attributes.Comment = params.Comment
+
+	return json.Marshal(body)
+}
@@ -0,0 +1,590 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// The IOSString struct stores an entry of an iOS .strings file
+type IOSString struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// The IOSStrings struct stores the entries of an iOS .strings file
+type IOSStrings struct {
+	Entries       []IOSString
+	FooterComment string // the comment following the last entry
+}
+
+// The IOSPluralRule struct stores a plural variable of an iOS .stringsdict entry
+type IOSPluralRule struct {
+	Variable  string        `json:"variable"`
+	ValueType string        `json:"value_type"` // the format specifier of the number, e.g. "d"
+	Strings   PluralStrings `json:"strings"`
+}
+
+// The IOSPluralString struct stores an entry of an iOS .stringsdict file
+type IOSPluralString struct {
+	Key       string          `json:"key"`
+	FormatKey string          `json:"format_key"` // the NSStringLocalizedFormatKey, e.g. "%#@files@"
+	Comment   string          `json:"comment,omitempty"`
+	Rules     []IOSPluralRule `json:"rules"`
+}
+
+// The IOSStringsDict struct stores the entries of an iOS .stringsdict file
+type IOSStringsDict struct {
+	Entries []IOSPluralString
+}
+
+var iosFormatVariable = regexp.MustCompile(`%(?:\d+\$)?#@([^@]+)@`)
+
+// The function parses an iOS .strings file (in UTF-8 or UTF-16 with a BOM)
+func ParseIOSStrings(r io.Reader) (*IOSStrings, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := iosStringsParser{src: []rune(decodeIOSText(data))}
+
+	f := &IOSStrings{}
+	var comments []string
+	for {
+		comment, isComment, err := p.skipSpace()
+		if err != nil {
+			return nil, err
+		}
+		if isComment {
+			if comment != "" {
+				comments = append(comments, comment)
+			}
+			continue
+		}
+		if p.eof() {
+			break
+		}
+
+		key, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		value, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		f.Entries = append(f.Entries, IOSString{Key: key, Value: value, Comment: strings.Join(comments, "\n")})
+		comments = nil
+	}
+	f.FooterComment = strings.Join(comments, "\n")
+
+	return f, nil
+}
+
+// The function writes the entries as an iOS .strings file in UTF-8
+func (f *IOSStrings) Write(w io.Writer) error {
+	var b bytes.Buffer
+	for _, e := range f.Entries {
+		if e.Comment != "" {
+			b.WriteString("/* " + cCommentText(e.Comment) + " */\n")
+		}
+		b.WriteString(iosQuote(e.Key) + " = " + iosQuote(e.Value) + ";\n\n")
+	}
+	if f.FooterComment != "" {
+		b.WriteString("/* " + cCommentText(f.FooterComment) + " */\n")
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// The function converts the entries into resource strings
+func (f *IOSStrings) ResourceStrings() []ResourceString {
+	strs := make([]ResourceString, 0, len(f.Entries))
+	for i, e := range f.Entries {
+		var s ResourceString
+		s.ID = e.Key
+		s.Attributes.Key = e.Key
+		s.Attributes.Strings.Other = e.Value
+		s.Attributes.DeveloperComment = e.Comment
+		s.Attributes.AppearanceOrder = i
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// The function returns the translated file: the entries having translations (by their keys)
+func (f *IOSStrings) Translate(translations map[string]PluralStrings) *IOSStrings {
+	t := &IOSStrings{FooterComment: f.FooterComment}
+	for _, e := range f.Entries {
+		if tr, ok := translations[e.Key]; ok && tr.Other != "" {
+			e.Value = tr.Other
+			t.Entries = append(t.Entries, e)
+		}
+	}
+	return t
+}
+
+// The function validates the entries: the duplicate keys and the empty values
+func (f *IOSStrings) Validate() []MobileStringIssue {
+	var issues []MobileStringIssue
+	seen := map[string]bool{}
+	for _, e := range f.Entries {
+		if seen[e.Key] {
+			issues = append(issues, MobileStringIssue{Key: e.Key, Reason: "duplicate key"})
+		}
+		seen[e.Key] = true
+		if e.Value == "" {
+			issues = append(issues, MobileStringIssue{Key: e.Key, Reason: "empty value"})
+		}
+	}
+	return issues
+}
+
+// The function parses an iOS .stringsdict file
+func ParseIOSStringsDict(r io.Reader) (*IOSStringsDict, error) {
+	dec := xml.NewDecoder(r)
+
+	// Find the top-level dictionary
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("the <dict> element is missed")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "dict" {
+			break
+		}
+	}
+
+	entries, err := parsePlistDict(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &IOSStringsDict{}
+	for _, e := range entries {
+		if e.dict == nil {
+			continue
+		}
+		ps := IOSPluralString{Key: e.key, Comment: e.comment}
+		for _, v := range e.dict {
+			switch {
+			case v.key == "NSStringLocalizedFormatKey":
+				ps.FormatKey = v.str
+			case v.dict != nil && plistDictString(v.dict, "NSStringFormatSpecTypeKey") == "NSStringPluralRuleType":
+				rule := IOSPluralRule{
+					Variable:  v.key,
+					ValueType: plistDictString(v.dict, "NSStringFormatValueTypeKey"),
+				}
+				for _, c := range PluralCategories {
+					rule.Strings.Set(c, plistDictString(v.dict, c))
+				}
+				ps.Rules = append(ps.Rules, rule)
+			}
+		}
+		f.Entries = append(f.Entries, ps)
+	}
+
+	return f, nil
+}
+
+// The function writes the entries as an iOS .stringsdict file
+func (f *IOSStringsDict) Write(w io.Writer) error {
+	var b bytes.Buffer
+	item := func(indent, key, value string) {
+		b.WriteString(indent + "<key>" + xliffEscape(key) + "</key>\n")
+		b.WriteString(indent + "<string>" + xliffEscape(value) + "</string>\n")
+	}
+
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	b.WriteString("<!DOCTYPE plist PUBLIC \"-//Apple//DTD PLIST 1.0//EN\" \"http://www.apple.com/DTDs/PropertyList-1.0.dtd\">\n")
+	b.WriteString("<plist version=\"1.0\">\n<dict>\n")
+
+	for _, e := range f.Entries {
+		if e.Comment != "" {
+			b.WriteString("\t<!-- " + xmlCommentText(e.Comment) + " -->\n")
+		}
+		b.WriteString("\t<key>" + xliffEscape(e.Key) + "</key>\n\t<dict>\n")
+		item("\t\t", "NSStringLocalizedFormatKey", e.FormatKey)
+		for _, rule := range e.Rules {
+			b.WriteString("\t\t<key>" + xliffEscape(rule.Variable) + "</key>\n\t\t<dict>\n")
+			item("\t\t\t", "NSStringFormatSpecTypeKey", "NSStringPluralRuleType")
+			item("\t\t\t", "NSStringFormatValueTypeKey", rule.ValueType)
+			for _, c := range rule.Strings.Categories() {
+				item("\t\t\t", c, rule.Strings.Get(c))
+			}
+			b.WriteString("\t\t</dict>\n")
+		}
+		b.WriteString("\t</dict>\n")
+	}
+
+	b.WriteString("</dict>\n</plist>\n")
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// The function converts the plural rules of the entries into pluralized resource strings.
+// The key of a string is the key of the entry or, for the entries with several
+// plural variables, the key of the entry and the variable joined with a dot.
+func (f *IOSStringsDict) ResourceStrings() []ResourceString {
+	var strs []ResourceString
+	for _, e := range f.Entries {
+		for _, rule := range e.Rules {
+			var s ResourceString
+			s.ID = iosPluralRuleKey(e, rule)
+			s.Attributes.Key = s.ID
+			s.Attributes.Strings = rule.Strings
+			s.Attributes.Pluralized = true
+			s.Attributes.DeveloperComment = e.Comment
+			s.Attributes.AppearanceOrder = len(strs)
+			strs = append(strs, s)
+		}
+	}
+	return strs
+}
+
+// The function returns the translated file: the entries having translations of any of their
+// plural variables (by the keys returned by ResourceStrings). The untranslated variables
+// keep the source values.
+func (f *IOSStringsDict) Translate(translations map[string]PluralStrings) *IOSStringsDict {
+	t := &IOSStringsDict{}
+	for _, e := range f.Entries {
+		rules := make([]IOSPluralRule, len(e.Rules))
+		translated := false
+		for i, rule := range e.Rules {
+			if tr, ok := translations[iosPluralRuleKey(e, rule)]; ok && len(tr.Categories()) > 0 {
+				rule.Strings = tr
+				translated = true
+			}
+			rules[i] = rule
+		}
+		if translated {
+			e.Rules = rules
+			t.Entries = append(t.Entries, e)
+		}
+	}
+	return t
+}
+
+// The function validates the entries: the duplicate keys, the plural variables
+// referenced by the format keys and the plural forms required by the language
+func (f *IOSStringsDict) Validate(lang Language) []MobileStringIssue {
+	var issues []MobileStringIssue
+	seen := map[string]bool{}
+
+	for _, e := range f.Entries {
+		if seen[e.Key] {
+			issues = append(issues, MobileStringIssue{Key: e.Key, Reason: "duplicate key"})
+		}
+		seen[e.Key] = true
+
+		if e.FormatKey == "" {
+			issues = append(issues, MobileStringIssue{Key: e.Key, Reason: "missed NSStringLocalizedFormatKey"})
+		}
+
+		// Check the variables referenced by the format key
+		referenced := map[string]bool{}
+		for _, m := range iosFormatVariable.FindAllStringSubmatch(e.FormatKey, -1) {
+			referenced[m[1]] = true
+		}
+		defined := map[string]bool{}
+		for _, rule := range e.Rules {
+			defined[rule.Variable] = true
+			if !referenced[rule.Variable] {
+				issues = append(issues, MobileStringIssue{Key: e.Key, Reason: fmt.Sprintf("variable '%s' is not used in the format key", rule.Variable)})
+			}
+			issues = append(issues, checkMobilePluralForms(iosPluralRuleKey(e, rule), rule.Strings, lang)...)
+		}
+		for v := range referenced {
+			if !defined[v] {
+				issues = append(issues, MobileStringIssue{Key: e.Key, Reason: fmt.Sprintf("variable '%s' of the format key is not defined", v)})
+			}
+		}
+	}
+
+	return issues
+}
+
+// The function returns the resource string key of a plural rule
+func iosPluralRuleKey(e IOSPluralString, rule IOSPluralRule) string {
+	if len(e.Rules) == 1 {
+		return e.Key
+	}
+	return e.Key + "." + rule.Variable
+}
+
+// An entry of a property list dictionary
+type plistEntry struct {
+	key     string
+	comment string
+	str     string
+	dict    []plistEntry
+}
+
+// The function parses the entries of a property list dictionary
+// (the opening <dict> element is already read)
+func parsePlistDict(dec *xml.Decoder) ([]plistEntry, error) {
+	var entries []plistEntry
+	var comments []string
+	key, hasKey := "", false
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tk := tok.(type) {
+
+		case xml.Comment:
+			comments = append(comments, strings.TrimSpace(string(tk)))
+
+		case xml.EndElement:
+			return entries, nil
+
+		case xml.StartElement:
+			if tk.Name.Local == "key" {
+				if err := dec.DecodeElement(&key, &tk); err != nil {
+					return nil, err
+				}
+				hasKey = true
+				continue
+			}
+			if !hasKey {
+				return nil, fmt.Errorf("unexpected element <%s> without a key", tk.Name.Local)
+			}
+
+			e := plistEntry{key: key, comment: strings.Join(comments, "\n")}
+			switch tk.Name.Local {
+			case "dict":
+				if e.dict, err = parsePlistDict(dec); err != nil {
+					return nil, err
+				}
+				if e.dict == nil {
+					e.dict = []plistEntry{}
+				}
+			case "string":
+				if err := dec.DecodeElement(&e.str, &tk); err != nil {
+					return nil, err
+				}
+			default:
+				if err := dec.Skip(); err != nil {
+					return nil, err
+				}
+			}
+			entries = append(entries, e)
+			comments = nil
+			key, hasKey = "", false
+		}
+	}
+}
+
+// The function returns the string value of a property list dictionary by its key
+func plistDictString(entries []plistEntry, key string) string {
+	for _, e := range entries {
+		if e.key == key {
+			return e.str
+		}
+	}
+	return ""
+}
+
+// The iOS .strings parser state
+type iosStringsParser struct {
+	src     []rune
+	pos     int
+	line    int // the number of the lines before the counted position
+	counted int // the position, up to which the lines are counted
+}
+
+// The function checks whether the whole file is read
+func (p *iosStringsParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+// The function checks whether the unread data starts with the prefix
+func (p *iosStringsParser) hasPrefix(prefix string) bool {
+	i := p.pos
+	for _, r := range prefix {
+		if i >= len(p.src) || p.src[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// The function skips the white spaces and reads the next comment, if any.
+// It returns the text of the comment and whether a comment was read.
+func (p *iosStringsParser) skipSpace() (string, bool, error) {
+	for !p.eof() && strings.ContainsRune(" \t\r\n", p.src[p.pos]) {
+		p.pos++
+	}
+
+	switch {
+	case p.hasPrefix("/*"):
+		p.pos += 2
+		start := p.pos
+		for !p.hasPrefix("*/") {
+			if p.eof() {
+				return "", false, p.errorf("unterminated comment")
+			}
+			p.pos++
+		}
+		p.pos += 2
+		return strings.TrimSpace(string(p.src[start : p.pos-2])), true, nil
+
+	case p.hasPrefix("//"):
+		p.pos += 2
+		start := p.pos
+		for !p.eof() && p.src[p.pos] != '\n' {
+			p.pos++
+		}
+		return strings.TrimSpace(string(p.src[start:p.pos])), true, nil
+	}
+
+	return "", false, nil
+}
+
+// The function reads a quoted or unquoted string
+func (p *iosStringsParser) token() (string, error) {
+	for {
+		_, isComment, err := p.skipSpace()
+		if err != nil {
+			return "", err
+		}
+		if !isComment {
+			break
+		}
+	}
+	if p.eof() {
+		return "", p.errorf("unexpected end of file")
+	}
+
+	if p.src[p.pos] != '"' {
+		start := p.pos
+		for p.pos < len(p.src) && (isIOSTokenRune(p.src[p.pos])) {
+			p.pos++
+		}
+		if start == p.pos {
+			return "", p.errorf("unexpected character '%c'", p.src[p.pos])
+		}
+		return string(p.src[start:p.pos]), nil
+	}
+
+	var b strings.Builder
+	for p.pos++; p.pos < len(p.src); p.pos++ {
+		r := p.src[p.pos]
+		switch {
+		case r == '"':
+			p.pos++
+			return b.String(), nil
+		case r == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteRune('\n')
+			case 't':
+				b.WriteRune('\t')
+			case 'r':
+				b.WriteRune('\r')
+			case '0':
+				b.WriteRune(0)
+			case 'u', 'U':
+				if p.pos+4 < len(p.src) {
+					if v, err := strconv.ParseUint(string(p.src[p.pos+1:p.pos+5]), 16, 32); err == nil {
+						b.WriteRune(rune(v))
+						p.pos += 4
+						break
+					}
+				}
+				b.WriteRune(e)
+			default:
+				b.WriteRune(e)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// The function reads the expected punctuation character
+func (p *iosStringsParser) expect(r rune) error {
+	for {
+		_, isComment, err := p.skipSpace()
+		if err != nil {
+			return err
+		}
+		if !isComment {
+			break
+		}
+	}
+	if p.eof() {
+		return p.errorf("unexpected end of file, '%c' is expected", r)
+	}
+	if p.src[p.pos] != r {
+		return p.errorf("unexpected character '%c', '%c' is expected", p.src[p.pos], r)
+	}
+	p.pos++
+	return nil
+}
+
+// The function returns an error with the current line number.
+// The lines are counted from the previously counted position only.
+func (p *iosStringsParser) errorf(format string, args ...any) error {
+	end := p.pos
+	if end > len(p.src) {
+		end = len(p.src)
+	}
+	for ; p.counted < end; p.counted++ {
+		if p.src[p.counted] == '\n' {
+			p.line++
+		}
+	}
+	return fmt.Errorf("line %d: %s", p.line+1, fmt.Sprintf(format, args...))
+}
+
+// The function checks whether the rune can be a part of an unquoted string
+func isIOSTokenRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_.-$:/", r)
+}
+
+// The function quotes and escapes a string of a .strings file
+func iosQuote(s string) string {
+	return "\"" + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`).Replace(s) + "\""
+}
+
+// The function decodes the text of a .strings file: UTF-16 with a BOM or UTF-8
+func decodeIOSText(data []byte) string {
+	if len(data) >= 2 && (data[0] == 0xFF && data[1] == 0xFE || data[0] == 0xFE && data[1] == 0xFF) {
+		bigEndian := data[0] == 0xFE
+		units := make([]uint16, 0, len(data)/2)
+		for i := 2; i+1 < len(data); i += 2 {
+			if bigEndian {
+				units = append(units, uint16(data[i])<<8|uint16(data[i+1]))
+			} else {
+				units = append(units, uint16(data[i+1])<<8|uint16(data[i]))
+			}
+		}
+		return string(utf16.Decode(units))
+	}
+	return strings.TrimPrefix(string(data), "\uFEFF")
+}
@@ -0,0 +1,52 @@
+package transifex_api_client
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseIOSStringsComments(t *testing.T) {
+	src := "/* Greeting */\n\"hello\" = \"Hello\";\n// Farewell\nbye = \"Bye\"; /**/\n/**/"
+	f, err := ParseIOSStrings(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Entries) != 2 {
+		t.Fatalf("%d entries, expected 2: %+v", len(f.Entries), f.Entries)
+	}
+	if e := f.Entries[0]; e.Key != "hello" || e.Value != "Hello" || e.Comment != "Greeting" {
+		t.Errorf("unexpected entry %+v", e)
+	}
+	if e := f.Entries[1]; e.Key != "bye" || e.Value != "Bye" || e.Comment != "Farewell" {
+		t.Errorf("unexpected entry %+v", e)
+	}
+}
+
+func TestParseIOSStringsErrors(t *testing.T) {
+	for src, expected := range map[string]string{
+		"\"a\" = \"b\";\n\"c\" = \"d\"\n":     "line 3: unexpected end of file, ';' is expected",
+		"\"a\" = \"b\";\n\n/* comment":        "line 3: unterminated comment",
+		"\"a\" = \"b\";\n\"c\" \"d\";\n":      "line 2: unexpected character '\"', '=' is expected",
+		"\"a\" = \"multi\nline\";\n\"c\" = ;": "line 3: unexpected character ';'",
+	} {
+		_, err := ParseIOSStrings(strings.NewReader(src))
+		if err == nil || err.Error() != expected {
+			t.Errorf("ParseIOSStrings(%q) error %v, expected %q", src, err, expected)
+		}
+	}
+}
+
+func TestParseIOSStringsLargeFile(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < 20000; i++ {
+		fmt.Fprintf(&b, "/* The comment of the string %d */\n\"key.%d\" = \"The value of the string %d\";\n\n", i, i, i)
+	}
+	f, err := ParseIOSStrings(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Entries) != 20000 {
+		t.Errorf("%d entries, expected 20000", len(f.Entries))
+	}
+}
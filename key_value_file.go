@@ -0,0 +1,508 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The key-value file formats supported by the adapters
+type KeyValueFormat string
+
+const (
+	KeyValueJSON KeyValueFormat = "json" // nested JSON, e.g. Jekyll _data or i18next
+	KeyValueYAML KeyValueFormat = "yaml" // nested YAML, e.g. Jekyll _data or Hugo i18n
+	KeyValueARB  KeyValueFormat = "arb"  // Flutter Application Resource Bundle
+)
+
+// The i18n format IDs (as returned by ListI18nFormats) handled by the key-value adapters
+var keyValueI18nFormats = map[string]KeyValueFormat{
+	"KEYVALUEJSON": KeyValueJSON,
+	"JSON":         KeyValueJSON,
+	"YML":          KeyValueYAML,
+	"YML_KEY":      KeyValueYAML,
+	"YAML_GENERIC": KeyValueYAML,
+	"FLUTTER":      KeyValueARB,
+	"ARB":          KeyValueARB,
+}
+
+// The file extensions used, when the i18n format ID is unknown
+var keyValueExtensions = map[string]KeyValueFormat{
+	".json": KeyValueJSON,
+	".yml":  KeyValueYAML,
+	".yaml": KeyValueYAML,
+	".arb":  KeyValueARB,
+}
+
+// The KeyValueEntry struct stores a translatable value of a key-value file.
+// The key is the dotted path of the value in the file, e.g. "nav.home" or "items.0.title"
+// (the dots and the backslashes in the keys of the file are escaped with a backslash).
+type KeyValueEntry struct {
+	Key        string        `json:"key"`
+	Strings    PluralStrings `json:"strings"`
+	Pluralized bool          `json:"pluralized"`
+	Comment    string        `json:"comment,omitempty"` // the description of an ARB message
+
+	node     *yaml.Node // the scalar node of the value or the mapping node of the plural forms
+	icu      string     // the variable of an ICU plural message
+	pluralOf bool       // the plural forms are stored as a mapping (Hugo style)
+}
+
+// The KeyValueFile struct stores a parsed key-value file, that can be rebuilt with translations
+type KeyValueFile struct {
+	Format  KeyValueFormat
+	Locale  string // the "@@locale" of an ARB file
+	Entries []KeyValueEntry
+	root    *yaml.Node
+}
+
+// The function returns the key-value format for an i18n format returned by ListI18nFormats.
+// The format is chosen by the format ID or, if it is unknown, by the file extensions.
+func KeyValueFormatFor(f I18nFormat) (KeyValueFormat, error) {
+	for _, id := range []string{f.ID, f.Attributes.Name} {
+		if kf, ok := keyValueI18nFormats[strings.ToUpper(id)]; ok {
+			return kf, nil
+		}
+	}
+	for _, ext := range f.Attributes.FileExtensions {
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		if kf, ok := keyValueExtensions[strings.ToLower(ext)]; ok {
+			return kf, nil
+		}
+	}
+	return "", fmt.Errorf("the i18n format '%s' is not supported by the key-value adapters", f.ID)
+}
+
+// Get the key-value format for an i18n format of the organization by the format ID
+func (t *TransifexApiClient) GetKeyValueFormat(organization_id, format_id string) (KeyValueFormat, error) {
+	formats, err := t.ListI18nFormats(ListI18nFormatsParameters{
+		OrganizationID: organization_id,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, f := range formats {
+		if f.ID == format_id || f.Attributes.Name == format_id {
+			return KeyValueFormatFor(f)
+		}
+	}
+
+	err = fmt.Errorf("unknown 'format_id' value")
+	t.l.Error(err)
+	return "", err
+}
+
+// The function parses a key-value file and flattens its string values into entries.
+// The ICU plural messages ("{count, plural, one {...} other {...}}") and the mappings
+// of the plural categories ("one: ...", "other: ...") become pluralized entries.
+func ParseKeyValueFile(data []byte, format KeyValueFormat) (*KeyValueFile, error) {
+	f := &KeyValueFile{Format: format}
+
+	switch format {
+
+	case KeyValueJSON, KeyValueARB:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		root, err := decodeJSONNode(dec)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := dec.Token(); err != io.EOF {
+			return nil, fmt.Errorf("unexpected data after the top-level JSON value")
+		}
+		f.root = root
+
+	case KeyValueYAML:
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		f.root = &doc
+
+	default:
+		return nil, fmt.Errorf("unknown 'format' value")
+	}
+
+	f.flatten(f.root, "")
+
+	// The ARB metadata: the locale and the descriptions of the messages
+	if format == KeyValueARB && f.root.Kind == yaml.MappingNode {
+		descriptions := map[string]string{}
+		for i := 0; i+1 < len(f.root.Content); i += 2 {
+			k, v := f.root.Content[i].Value, f.root.Content[i+1]
+			switch {
+			case k == "@@locale":
+				f.Locale = v.Value
+			case strings.HasPrefix(k, "@") && v.Kind == yaml.MappingNode:
+				if d := yamlMappingValue(v, "description"); d != nil {
+					descriptions[escapeKeyValueKey(k[1:])] = d.Value
+				}
+			}
+		}
+		for i := range f.Entries {
+			f.Entries[i].Comment = descriptions[f.Entries[i].Key]
+		}
+	}
+
+	return f, nil
+}
+
+// The function converts the entries into resource strings, that can be pushed to the service
+// or exported with the PO and XLIFF exporters
+func (f *KeyValueFile) ResourceStrings() []ResourceString {
+	strs := make([]ResourceString, 0, len(f.Entries))
+	for i, e := range f.Entries {
+		var s ResourceString
+		s.ID = e.Key
+		s.Attributes.Key = e.Key
+		s.Attributes.Strings = e.Strings
+		s.Attributes.Pluralized = e.Pluralized
+		s.Attributes.DeveloperComment = e.Comment
+		s.Attributes.AppearanceOrder = i
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// The function writes the file with the translations of the entries (by their keys).
+// The structure, the order and the non-translatable values of the source file are kept;
+// the entries without translations keep the source values.
+// The locale, if not empty, replaces the "@@locale" of an ARB file.
+func (f *KeyValueFile) Build(w io.Writer, translations map[string]PluralStrings, locale string) error {
+	clones := map[*yaml.Node]*yaml.Node{}
+	root := cloneYAMLNode(f.root, clones)
+
+	for _, e := range f.Entries {
+		tr, ok := translations[e.Key]
+		if !ok || len(tr.Categories()) == 0 {
+			continue
+		}
+		n := clones[e.node]
+
+		switch {
+		case e.pluralOf:
+			n.Content = nil
+			for _, c := range tr.Categories() {
+				n.Content = append(n.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: c},
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: tr.Get(c)})
+			}
+		case e.icu != "":
+			n.Value = formatICUPlural(e.icu, tr)
+		default:
+			n.Value = tr.Other
+		}
+	}
+
+	if f.Format == KeyValueARB && locale != "" && root.Kind == yaml.MappingNode {
+		if n := yamlMappingValue(root, "@@locale"); n != nil {
+			n.Value = locale
+		}
+	}
+
+	if f.Format == KeyValueYAML {
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(root); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+
+	var b bytes.Buffer
+	writeJSONNode(&b, root, "")
+	b.WriteString("\n")
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// The function writes the file with the translations of the resource strings,
+// that were created from the entries (the strings are matched by their keys)
+func (f *KeyValueFile) BuildFromTranslations(w io.Writer, strs []ResourceString, trs []ResourceTranslation, locale string) error {
+	return f.Build(w, TranslationsByKey(strs, trs), locale)
+}
+
+// The function collects the string values of the node into the entries
+func (f *KeyValueFile) flatten(n *yaml.Node, path string) {
+	join := func(k string) string {
+		if path == "" {
+			return k
+		}
+		return path + "." + k
+	}
+
+	switch n.Kind {
+
+	case yaml.DocumentNode:
+		for _, c := range n.Content {
+			f.flatten(c, path)
+		}
+
+	case yaml.MappingNode:
+		if isPluralMapping(n) {
+			e := KeyValueEntry{Key: path, Pluralized: true, node: n, pluralOf: true}
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				e.Strings.Set(n.Content[i].Value, n.Content[i+1].Value)
+			}
+			f.Entries = append(f.Entries, e)
+			return
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i].Value
+			// The ARB metadata is not translatable
+			if f.Format == KeyValueARB && path == "" && strings.HasPrefix(k, "@") {
+				continue
+			}
+			f.flatten(n.Content[i+1], join(escapeKeyValueKey(k)))
+		}
+
+	case yaml.SequenceNode:
+		for i, c := range n.Content {
+			f.flatten(c, join(strconv.Itoa(i)))
+		}
+
+	case yaml.ScalarNode:
+		if n.ShortTag() != "!!str" || strings.TrimSpace(n.Value) == "" {
+			return
+		}
+		e := KeyValueEntry{Key: path, node: n}
+		if v, p, ok := parseICUPlural(n.Value); ok {
+			e.Strings = p
+			e.Pluralized = true
+			e.icu = v
+		} else {
+			e.Strings.Other = n.Value
+		}
+		f.Entries = append(f.Entries, e)
+	}
+}
+
+// The function checks whether the mapping stores the plural forms of a string,
+// i.e. all its keys are CLDR plural categories including "other"
+func isPluralMapping(n *yaml.Node) bool {
+	if len(n.Content) == 0 || yamlMappingValue(n, "other") == nil {
+		return false
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if !containsString(PluralCategories, n.Content[i].Value) ||
+			n.Content[i+1].Kind != yaml.ScalarNode || n.Content[i+1].ShortTag() != "!!str" {
+			return false
+		}
+	}
+	return true
+}
+
+// The function returns the value of a mapping node by its key
+func yamlMappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// The function escapes the dots and the backslashes in a key of the file
+func escapeKeyValueKey(k string) string {
+	return strings.NewReplacer(`\`, `\\`, ".", `\.`).Replace(k)
+}
+
+// The function makes a deep copy of the node; the copies are stored in the map by the originals
+func cloneYAMLNode(n *yaml.Node, clones map[*yaml.Node]*yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	clones[n] = &c
+	c.Content = make([]*yaml.Node, len(n.Content))
+	for i, child := range n.Content {
+		c.Content[i] = cloneYAMLNode(child, clones)
+	}
+	if a, ok := clones[n.Alias]; ok {
+		c.Alias = a
+	}
+	return &c
+}
+
+// The function decodes a JSON value into a YAML node keeping the order of the object keys
+func decodeJSONNode(dec *json.Decoder) (*yaml.Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := tok.(type) {
+
+	case json.Delim:
+		n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if v == '[' {
+			n = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		}
+		for dec.More() {
+			if n.Kind == yaml.MappingNode {
+				k, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k.(string)})
+			}
+			c, err := decodeJSONNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			n.Content = append(n.Content, c)
+		}
+		// Read the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return n, nil
+
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}, nil
+
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}, nil
+
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}, nil
+
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
+
+// The function writes a node decoded by decodeJSONNode as an indented JSON value
+func writeJSONNode(b *bytes.Buffer, n *yaml.Node, indent string) {
+	switch n.Kind {
+
+	case yaml.MappingNode, yaml.SequenceNode:
+		open, close := "{", "}"
+		step := 2
+		if n.Kind == yaml.SequenceNode {
+			open, close = "[", "]"
+			step = 1
+		}
+		if len(n.Content) == 0 {
+			b.WriteString(open + close)
+			return
+		}
+		b.WriteString(open + "\n")
+		for i := 0; i < len(n.Content); i += step {
+			b.WriteString(indent + "  ")
+			if step == 2 {
+				b.WriteString(jsonString(n.Content[i].Value) + ": ")
+			}
+			writeJSONNode(b, n.Content[i+step-1], indent+"  ")
+			if i+step < len(n.Content) {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + close)
+
+	default:
+		if n.ShortTag() == "!!str" {
+			b.WriteString(jsonString(n.Value))
+		} else {
+			b.WriteString(n.Value)
+		}
+	}
+}
+
+// The function quotes a JSON string without escaping the HTML characters
+func jsonString(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// The function parses an ICU plural message, e.g. "{count, plural, one {# file} other {# files}}".
+// Only the messages consisting of a single plural argument with the CLDR categories are accepted.
+func parseICUPlural(msg string) (string, PluralStrings, bool) {
+	var p PluralStrings
+
+	s := strings.TrimSpace(msg)
+	if !strings.HasPrefix(s, "{") || matchingBrace(s, 0) != len(s)-1 {
+		return "", p, false
+	}
+
+	parts := strings.SplitN(s[1:len(s)-1], ",", 3)
+	if len(parts) != 3 || strings.TrimSpace(parts[1]) != "plural" {
+		return "", p, false
+	}
+	variable := strings.TrimSpace(parts[0])
+
+	rest := parts[2]
+	for {
+		rest = strings.TrimLeft(rest, " \t\n")
+		if rest == "" {
+			break
+		}
+		i := strings.IndexAny(rest, " \t\n{")
+		if i <= 0 {
+			return "", p, false
+		}
+		category := rest[:i]
+		if !containsString(PluralCategories, category) {
+			return "", p, false
+		}
+		rest = strings.TrimLeft(rest[i:], " \t\n")
+		end := matchingBrace(rest, 0)
+		if end < 0 {
+			return "", p, false
+		}
+		p.Set(category, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	if p.Other == "" {
+		return "", p, false
+	}
+	return variable, p, true
+}
+
+// The function formats the plural forms as an ICU plural message
+func formatICUPlural(variable string, p PluralStrings) string {
+	var b strings.Builder
+	b.WriteString("{" + variable + ", plural,")
+	for _, c := range p.Categories() {
+		b.WriteString(" " + c + " {" + p.Get(c) + "}")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// The function returns the position of the brace closing the one at the position i, or -1
+func matchingBrace(s string, i int) int {
+	if i >= len(s) || s[i] != '{' {
+		return -1
+	}
+	depth := 0
+	for j := i; j < len(s); j++ {
+		switch s[j] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
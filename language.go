@@ -18,7 +18,9 @@ type Language struct {
 		Rtl            bool   `json:"rtl"`
 		PluralEquation string `json:"plural_equation"`
 		PluralRules    struct {
+			Zero  string `json:"zero"`
 			One   string `json:"one"`
+			Two   string `json:"two"`
 			Many  string `json:"many"`
 			Few   string `json:"few"`
 			Other string `json:"other"`
@@ -151,7 +153,9 @@ func (t *TransifexApiClient) PrintLanguage(l Language, formatter string) {
 		fmt.Printf("    Rtl: %v\n", l.Attributes.Rtl)
 		fmt.Printf("    PluralEquation: %v\n", l.Attributes.PluralEquation)
 		fmt.Printf("    PluralRules:\n")
+		fmt.Printf("      Zero: %v\n", l.Attributes.PluralRules.Zero)
 		fmt.Printf("      One: %v\n", l.Attributes.PluralRules.One)
+		fmt.Printf("      Two: %v\n", l.Attributes.PluralRules.Two)
 		fmt.Printf("      Many: %v\n", l.Attributes.PluralRules.Many)
 		fmt.Printf("      Few: %v\n", l.Attributes.PluralRules.Few)
 		fmt.Printf("      Other: %v\n", l.Attributes.PluralRules.Other)
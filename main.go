@@ -1,7 +1,10 @@
 package transifex_api_client
 
 import (
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
@@ -29,3 +32,35 @@ func New(config *Config) (*TransifexApiClient, error) {
 	tr.configureLogger(config)
 	return tr, nil
 }
+
+// The function extracts the value of the pagination cursor from a 'next' link
+// of a service response. It returns an empty string for the last page.
+func cursorFromLink(link string) string {
+	if link == "" {
+		return ""
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return u.Query().Get("page[cursor]")
+}
+
+// The function converts an optional "true"/"false" parameter value into a bool pointer.
+// It returns nil for an empty value.
+func parseOptionalBool(value, name string) (*bool, error) {
+	var b bool
+	switch strings.ToLower(value) {
+	case "true":
+		b = true
+	case "false":
+		b = false
+	case "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown '%s' value", name)
+	}
+	return &b, nil
+}
@@ -0,0 +1,631 @@
+package transifex_api_client
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The kinds of the Markdown segments
+const (
+	MarkdownFrontMatter = "front-matter"
+	MarkdownHeading     = "heading"
+	MarkdownParagraph   = "paragraph"
+	MarkdownListItem    = "list-item"
+	MarkdownBlockquote  = "blockquote"
+	MarkdownTableCell   = "table-cell"
+)
+
+// The options of the Markdown segmentation
+type MarkdownSegmenterOptions struct {
+	Path            string   // the path of the episode file, used in the occurrences of the segments
+	KeyPrefix       string   // the prefix of the segment keys, e.g. "intro."
+	FrontMatterKeys []string // the translatable keys of the YAML front matter ("title", if not set)
+}
+
+// The MarkdownSegment struct stores a translatable unit of a Markdown file.
+// The inline code, Liquid tags and Pandoc attributes are replaced by placeholders
+// "<c1/>", "<c2/>", etc., that should be kept in the translations.
+type MarkdownSegment struct {
+	Key          string   `json:"key"`
+	Kind         string   `json:"kind"`
+	Text         string   `json:"text"`
+	Placeholders []string `json:"placeholders,omitempty"` // the original values of the placeholders
+	Callout      string   `json:"callout,omitempty"`      // the enclosing callouts, e.g. "challenge/solution"
+	Line         int      `json:"line"`
+}
+
+// The MarkdownDocument struct stores the segments of a Markdown file
+// together with the non-translatable skeleton, that is used to rebuild the file
+type MarkdownDocument struct {
+	Segments []MarkdownSegment
+	path     string
+	parts    []markdownPart
+}
+
+// A piece of the document skeleton: a literal text or a reference to a segment
+type markdownPart struct {
+	literal   string
+	segment   int    // the index of the segment, -1 for the literals
+	lineBreak string // the line end and the prefix of the continuation lines of a multi-line segment
+	yaml      bool   // the segment is a front matter value
+	raw       string // the original front matter value
+}
+
+var (
+	markdownFence     = regexp.MustCompile("^(\\s*)(`{3,}|~{3,})")
+	markdownCallout   = regexp.MustCompile(`^\s*:{3,}\s*(.*?)[\s:]*$`)
+	markdownCalloutID = regexp.MustCompile(`^\{[^{}]*?\.([\w-]+)|^\{\s*#([\w-]+)|^([\w-]+)`)
+	markdownIAL       = regexp.MustCompile(`^\s*\{:.*\}\s*$`)
+	markdownHeading   = regexp.MustCompile(`^(#{1,6}\s+)(.*?)(\s*\{[^{}]*\})?\s*$`)
+	markdownListItem  = regexp.MustCompile(`^(\s*(?:[-*+]|\d+[.)])\s+)(.*)$`)
+	markdownQuote     = regexp.MustCompile(`^((?:\s*>\s?)+)(.*)$`)
+	markdownIALClass  = regexp.MustCompile(`\.([\w-]+)`)
+	markdownTableSep  = regexp.MustCompile(`^\s*\|?[\s:|-]+\|?\s*$`)
+	markdownLiquid    = regexp.MustCompile(`^\s*\{%.*%\}\s*$`)
+	markdownFrontKey  = regexp.MustCompile(`^([A-Za-z_][\w-]*):(\s*)(.*)$`)
+	markdownInline    = regexp.MustCompile(`\{\{.*?\}\}|\{%.*?%\}|\{[.#][^{}]*\}|\{[\w-]+=[^{}]*\}`)
+	markdownPlacehold = regexp.MustCompile(`<c(\d+)/>`)
+)
+
+// The function splits a Carpentries-style Markdown episode into translatable segments.
+// The YAML front matter values, the headings, the paragraphs, the list items, the block quotes
+// and the table cells are translatable; the fenced code blocks, the callout fences,
+// the Liquid tags, the Kramdown attribute lists and the HTML comments are kept as is.
+// The callouts of the segments are taken both from the fenced divs ("::: challenge")
+// and the Kramdown block quotes ("> ..." followed by "{: .challenge}").
+// The keys of the segments are derived from their texts, so they do not change,
+// when other parts of the file are edited.
+func SegmentMarkdown(src string, opts MarkdownSegmenterOptions) *MarkdownDocument {
+	s := markdownSegmenter{
+		doc:         &MarkdownDocument{path: opts.Path},
+		opts:        opts,
+		keys:        map[string]int{},
+		frontKeys:   map[string]bool{},
+		kramdown:    map[int][]string{},
+		segCallouts: map[int]string{},
+	}
+	if len(opts.FrontMatterKeys) == 0 {
+		s.frontKeys["title"] = true
+	}
+	for _, k := range opts.FrontMatterKeys {
+		s.frontKeys[k] = true
+	}
+
+	s.run(strings.SplitAfter(src, "\n"))
+	return s.doc
+}
+
+// The function rebuilds the Markdown file with the translations of the segments (by their keys).
+// The segments without translations, or with translations missing some placeholders,
+// are kept in the source language; the latter are reported in the returned error.
+func (d *MarkdownDocument) Assemble(translations map[string]string) (string, error) {
+	var b strings.Builder
+	var errs []error
+
+	for _, p := range d.parts {
+		if p.segment < 0 {
+			b.WriteString(p.literal)
+			continue
+		}
+
+		seg := d.Segments[p.segment]
+		text := seg.Text
+		translated := false
+		if tr, ok := translations[seg.Key]; ok && tr != "" {
+			if err := checkMarkdownPlaceholders(seg, tr); err != nil {
+				errs = append(errs, fmt.Errorf("segment '%s' (line %d): %w", seg.Key, seg.Line, err))
+			} else {
+				text = tr
+				translated = true
+			}
+		}
+		text = restoreMarkdownPlaceholders(text, seg.Placeholders)
+
+		if p.yaml && !translated {
+			text = p.raw
+		} else if p.yaml {
+			quoted, _ := json.Marshal(text)
+			text = string(quoted)
+		} else if p.lineBreak != "" {
+			text = strings.ReplaceAll(text, "\n", p.lineBreak)
+		}
+		b.WriteString(text)
+	}
+
+	return b.String(), errors.Join(errs...)
+}
+
+// The function rebuilds the Markdown file with the translations of the resource strings,
+// that were created from the segments (the strings are matched by their keys)
+func (d *MarkdownDocument) AssembleFromTranslations(strs []ResourceString, trs []ResourceTranslation) (string, error) {
+	translations := map[string]string{}
+	for key, tr := range TranslationsByKey(strs, trs) {
+		translations[key] = tr.Other
+	}
+
+	return d.Assemble(translations)
+}
+
+// The function converts the segments into resource strings, that can be pushed to the service
+// or exported with the PO and XLIFF exporters
+func (d *MarkdownDocument) ResourceStrings() []ResourceString {
+	strs := make([]ResourceString, 0, len(d.Segments))
+	for i, seg := range d.Segments {
+		var s ResourceString
+		s.ID = seg.Key
+		s.Attributes.Key = seg.Key
+		s.Attributes.Strings.Other = seg.Text
+		s.Attributes.AppearanceOrder = i
+		s.Attributes.Context = seg.Callout
+		s.Attributes.Tags = []string{seg.Kind}
+		if d.path != "" {
+			s.Attributes.Occurrences = d.path + ":" + strconv.Itoa(seg.Line)
+		}
+		if len(seg.Placeholders) > 0 {
+			s.Attributes.DeveloperComment = "Keep the placeholders <c1/>, <c2/>, ... in the translation"
+		}
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// The state of the Markdown segmentation
+type markdownSegmenter struct {
+	doc       *MarkdownDocument
+	opts      MarkdownSegmenterOptions
+	keys      map[string]int // the number of the segments with the same key
+	frontKeys map[string]bool
+	callouts  []string
+
+	// The Kramdown callouts (block quotes followed by "{: .challenge}"): the indexes
+	// of the first segments of the enclosing quotes by their depth, the names of
+	// the callouts and the enclosing fenced callouts of the segments
+	quoteStarts []int
+	kramdown    map[int][]string
+	segCallouts map[int]string
+
+	// The paragraph being collected
+	para      []string
+	paraKind  string
+	paraStart int
+	prefix    string
+	cont      string
+	quote     string // the quote prefix of the paragraph inside a block quote
+	br        string // the line end of the first line
+	eol       string // the line end of the last line
+}
+
+// The function processes the lines of the file
+func (s *markdownSegmenter) run(lines []string) {
+	i := 0
+
+	// The YAML front matter
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		end := -1
+		for j := 1; j < len(lines); j++ {
+			if t := strings.TrimSpace(lines[j]); t == "---" || t == "..." {
+				end = j
+				break
+			}
+		}
+		if end > 0 {
+			s.literal(lines[0])
+			for j := 1; j < end; j++ {
+				s.frontMatterLine(lines[j], j+1)
+			}
+			s.literal(lines[end])
+			i = end + 1
+		}
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		text := strings.TrimRight(line, "\r\n")
+		eol := line[len(text):]
+		lineNo := i + 1
+		s.trackQuotes(text)
+
+		switch {
+
+		// A fenced code block is kept as is
+		case markdownFence.MatchString(text):
+			s.flush()
+			m := markdownFence.FindStringSubmatch(text)
+			fence := m[2]
+			s.literal(line)
+			for i+1 < len(lines) {
+				i++
+				s.literal(lines[i])
+				t := strings.TrimSpace(lines[i])
+				if strings.HasPrefix(t, fence) && strings.Trim(t, fence[:1]) == "" {
+					break
+				}
+			}
+
+		// An HTML comment is kept as is
+		case strings.HasPrefix(strings.TrimSpace(text), "<!--"):
+			s.flush()
+			s.literal(line)
+			for !strings.Contains(strings.TrimRight(lines[i], "\r\n"), "-->") && i+1 < len(lines) {
+				i++
+				s.literal(lines[i])
+			}
+
+		case strings.TrimSpace(text) == "":
+			s.flush()
+			s.literal(line)
+
+		// A callout fence opens ("::: challenge", "::: {.hint}") or closes (a bare ":::") a callout
+		case markdownCallout.MatchString(text):
+			s.flush()
+			if name := markdownCalloutName(markdownCallout.FindStringSubmatch(text)[1]); name != "" {
+				s.callouts = append(s.callouts, name)
+			} else if len(s.callouts) > 0 {
+				s.callouts = s.callouts[:len(s.callouts)-1]
+			}
+			s.literal(line)
+
+		// A Kramdown attribute list, e.g. "{: .challenge}", ends the block quote or paragraph
+		case markdownIAL.MatchString(text):
+			s.flush()
+			s.literal(line)
+
+		case markdownLiquid.MatchString(text):
+			s.flush()
+			s.literal(line)
+
+		case markdownHeading.MatchString(text):
+			s.flush()
+			m := markdownHeading.FindStringSubmatch(text)
+			s.literal(m[1])
+			s.segment(MarkdownHeading, m[2], lineNo, "", false)
+			s.literal(m[3] + eol)
+
+		// A table row is split into cells, the separator rows are kept as is
+		case strings.HasPrefix(strings.TrimSpace(text), "|"):
+			s.flush()
+			if markdownTableSep.MatchString(text) {
+				s.literal(line)
+				break
+			}
+			s.tableRow(text, lineNo)
+			s.literal(eol)
+
+		case markdownListItem.MatchString(text):
+			s.flush()
+			m := markdownListItem.FindStringSubmatch(text)
+			s.startParagraph(MarkdownListItem, m[1], strings.Repeat(" ", len(m[1])), eol, lineNo)
+			s.para = append(s.para, m[2])
+			s.eol = eol
+
+		// A block quote: the nested headings, list items, code blocks and attribute lists
+		// are processed like the top-level ones, the quote prefixes are kept as is
+		case markdownQuote.MatchString(text):
+			m := markdownQuote.FindStringSubmatch(text)
+			content := m[2]
+			switch {
+
+			// The empty quote lines separate the paragraphs
+			case strings.TrimSpace(content) == "" || markdownIAL.MatchString(content):
+				s.flush()
+				s.literal(line)
+
+			case markdownFence.MatchString(content):
+				s.flush()
+				fence := markdownFence.FindStringSubmatch(content)[2]
+				s.literal(line)
+				for i+1 < len(lines) {
+					qm := markdownQuote.FindStringSubmatch(strings.TrimRight(lines[i+1], "\r\n"))
+					if qm == nil {
+						break
+					}
+					i++
+					s.literal(lines[i])
+					if t := strings.TrimSpace(qm[2]); strings.HasPrefix(t, fence) && strings.Trim(t, fence[:1]) == "" {
+						break
+					}
+				}
+
+			case markdownHeading.MatchString(content):
+				s.flush()
+				hm := markdownHeading.FindStringSubmatch(content)
+				s.literal(m[1] + hm[1])
+				s.segment(MarkdownHeading, hm[2], lineNo, "", false)
+				s.literal(hm[3] + eol)
+
+			case markdownListItem.MatchString(content):
+				s.flush()
+				lm := markdownListItem.FindStringSubmatch(content)
+				s.startParagraph(MarkdownListItem, m[1]+lm[1], m[1], eol, lineNo)
+				s.quote = m[1]
+				s.para = append(s.para, lm[2])
+				s.eol = eol
+
+			// A line with the same prefix continues the paragraph, another prefix starts a new one
+			case s.paraKind != "" && s.quote == m[1]:
+				s.para = append(s.para, content)
+				s.eol = eol
+
+			default:
+				s.flush()
+				s.startParagraph(MarkdownBlockquote, m[1], m[1], eol, lineNo)
+				s.quote = m[1]
+				s.para = append(s.para, content)
+				s.eol = eol
+			}
+
+		// A continuation line of the current paragraph or list item
+		case s.paraKind != "":
+			s.para = append(s.para, strings.TrimLeft(text, " \t"))
+			s.eol = eol
+
+		default:
+			indent := text[:len(text)-len(strings.TrimLeft(text, " \t"))]
+			s.startParagraph(MarkdownParagraph, indent, indent, eol, lineNo)
+			s.para = append(s.para, strings.TrimLeft(text, " \t"))
+			s.eol = eol
+		}
+	}
+	s.flush()
+}
+
+// The function tracks the block quotes of the Kramdown callouts. A quote followed by
+// an attribute list with a class, e.g. "{: .challenge}" or "> {: .solution}" for a nested one,
+// is a callout: the class is added to the callouts of the segments of the quote.
+func (s *markdownSegmenter) trackQuotes(text string) {
+	depth, content := 0, text
+	if m := markdownQuote.FindStringSubmatch(text); m != nil {
+		depth, content = strings.Count(m[1], ">"), m[2]
+	}
+
+	// The attribute list closes the quote one level deeper
+	if markdownIAL.MatchString(content) && len(s.quoteStarts) > depth {
+		s.flush()
+		if c := markdownIALClass.FindStringSubmatch(content); c != nil {
+			for i := s.quoteStarts[depth]; i < len(s.doc.Segments); i++ {
+				if _, ok := s.segCallouts[i]; !ok {
+					s.segCallouts[i] = s.doc.Segments[i].Callout
+				}
+				s.kramdown[i] = append([]string{c[1]}, s.kramdown[i]...)
+
+				var callouts []string
+				if s.segCallouts[i] != "" {
+					callouts = append(callouts, s.segCallouts[i])
+				}
+				s.doc.Segments[i].Callout = strings.Join(append(callouts, s.kramdown[i]...), "/")
+			}
+		}
+	}
+
+	// The deeper quotes are closed, the new ones start with the next segment
+	if len(s.quoteStarts) > depth {
+		s.quoteStarts = s.quoteStarts[:depth]
+	}
+	if len(s.quoteStarts) < depth {
+		s.flush()
+		for len(s.quoteStarts) < depth {
+			s.quoteStarts = append(s.quoteStarts, len(s.doc.Segments))
+		}
+	}
+}
+
+// The function returns the name of the callout opened by the fence attributes:
+// the word ("challenge"), the first class ("{.hint}", "{#id .hint}") or the ID ("{#id}").
+// An empty string is returned for the closing fence.
+func markdownCalloutName(attrs string) string {
+	m := markdownCalloutID.FindStringSubmatch(attrs)
+	if m == nil {
+		if attrs != "" {
+			return "div"
+		}
+		return ""
+	}
+	for _, name := range m[1:] {
+		if name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// The function processes a line of the YAML front matter
+func (s *markdownSegmenter) frontMatterLine(line string, lineNo int) {
+	m := markdownFrontKey.FindStringSubmatch(strings.TrimRight(line, "\r\n"))
+	if m == nil || !s.frontKeys[m[1]] || strings.TrimSpace(m[3]) == "" {
+		s.literal(line)
+		return
+	}
+
+	// Only the scalar values are translatable
+	var value string
+	if err := yaml.Unmarshal([]byte(m[3]), &value); err != nil || !isMarkdownTranslatable(value) {
+		s.literal(line)
+		return
+	}
+
+	s.literal(m[1] + ":" + m[2])
+	s.segment(MarkdownFrontMatter, value, lineNo, "", true)
+	s.doc.parts[len(s.doc.parts)-1].raw = m[3]
+	s.literal(line[len(strings.TrimRight(line, "\r\n")):])
+}
+
+// The function splits a table row into the cell segments
+func (s *markdownSegmenter) tableRow(text string, lineNo int) {
+	cells := strings.Split(text, "|")
+	for i, cell := range cells {
+		if i > 0 {
+			s.literal("|")
+		}
+		trimmed := strings.TrimSpace(cell)
+		if trimmed == "" {
+			s.literal(cell)
+			continue
+		}
+		start := strings.Index(cell, trimmed)
+		s.literal(cell[:start])
+		s.segment(MarkdownTableCell, trimmed, lineNo, "", false)
+		s.literal(cell[start+len(trimmed):])
+	}
+}
+
+// The function starts collecting a multi-line segment
+func (s *markdownSegmenter) startParagraph(kind, prefix, cont, eol string, lineNo int) {
+	s.paraKind = kind
+	s.paraStart = lineNo
+	s.prefix = prefix
+	s.cont = cont
+	s.quote = ""
+	s.br = eol
+	s.para = nil
+}
+
+// The function completes the current multi-line segment
+func (s *markdownSegmenter) flush() {
+	if s.paraKind == "" {
+		return
+	}
+
+	s.literal(s.prefix)
+	s.segment(s.paraKind, strings.Join(s.para, "\n"), s.paraStart, s.br+s.cont, false)
+	s.literal(s.eol)
+
+	s.paraKind = ""
+	s.para = nil
+}
+
+// The function adds a literal to the skeleton
+func (s *markdownSegmenter) literal(text string) {
+	if text == "" {
+		return
+	}
+	if n := len(s.doc.parts); n > 0 && s.doc.parts[n-1].segment == -1 {
+		s.doc.parts[n-1].literal += text
+		return
+	}
+	s.doc.parts = append(s.doc.parts, markdownPart{literal: text, segment: -1})
+}
+
+// The function adds a segment to the document. The texts without translatable content
+// (e.g. a single inline code or a Liquid tag) are added as literals.
+func (s *markdownSegmenter) segment(kind, text string, lineNo int, lineBreak string, yamlValue bool) {
+	if !isMarkdownTranslatable(text) {
+		if lineBreak != "" {
+			text = strings.ReplaceAll(text, "\n", lineBreak)
+		}
+		s.literal(text)
+		return
+	}
+
+	protected, placeholders := protectMarkdownInline(text)
+
+	// The key is derived from the text; the repeated texts get a numeric suffix
+	sum := md5.Sum([]byte(text))
+	key := s.opts.KeyPrefix + hex.EncodeToString(sum[:8])
+	s.keys[key]++
+	if n := s.keys[key]; n > 1 {
+		key += "." + strconv.Itoa(n)
+	}
+
+	s.doc.Segments = append(s.doc.Segments, MarkdownSegment{
+		Key:          key,
+		Kind:         kind,
+		Text:         protected,
+		Placeholders: placeholders,
+		Callout:      strings.Join(s.callouts, "/"),
+		Line:         lineNo,
+	})
+	s.doc.parts = append(s.doc.parts, markdownPart{
+		segment:   len(s.doc.Segments) - 1,
+		lineBreak: lineBreak,
+		yaml:      yamlValue,
+	})
+}
+
+// The function replaces the inline code spans, the Liquid tags and the Pandoc attributes
+// with the placeholders
+func protectMarkdownInline(text string) (string, []string) {
+	var placeholders []string
+	add := func(v string) string {
+		placeholders = append(placeholders, v)
+		return "<c" + strconv.Itoa(len(placeholders)) + "/>"
+	}
+
+	var b strings.Builder
+	rest := text
+	for rest != "" {
+		// Find the next code span: a run of backticks closed by a run of the same length
+		i := strings.Index(rest, "`")
+		if i < 0 {
+			break
+		}
+		n := len(rest[i:]) - len(strings.TrimLeft(rest[i:], "`"))
+		fence := rest[i : i+n]
+		j := strings.Index(rest[i+n:], fence)
+		for j >= 0 && strings.HasPrefix(rest[i+n+j+n:], "`") {
+			// The closing run is longer than the opening one
+			k := strings.Index(rest[i+n+j+n:], fence)
+			if k < 0 {
+				j = -1
+				break
+			}
+			j += n + k
+		}
+		if j < 0 {
+			break
+		}
+
+		b.WriteString(markdownInline.ReplaceAllStringFunc(rest[:i], add))
+		b.WriteString(add(rest[i : i+n+j+n]))
+		rest = rest[i+n+j+n:]
+	}
+	b.WriteString(markdownInline.ReplaceAllStringFunc(rest, add))
+
+	return b.String(), placeholders
+}
+
+// The function replaces the placeholders with their original values
+func restoreMarkdownPlaceholders(text string, placeholders []string) string {
+	return markdownPlacehold.ReplaceAllStringFunc(text, func(p string) string {
+		n, _ := strconv.Atoi(markdownPlacehold.FindStringSubmatch(p)[1])
+		if n < 1 || n > len(placeholders) {
+			return p
+		}
+		return placeholders[n-1]
+	})
+}
+
+// The function checks, that the translation keeps all the placeholders of the segment
+func checkMarkdownPlaceholders(seg MarkdownSegment, translation string) error {
+	var missing []string
+	for i := range seg.Placeholders {
+		p := "<c" + strconv.Itoa(i+1) + "/>"
+		if !strings.Contains(translation, p) {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("the translation misses the placeholders %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// The function checks whether the text has anything to translate
+// besides the inline code, the tags, the numbers and the punctuation
+func isMarkdownTranslatable(text string) bool {
+	protected, _ := protectMarkdownInline(text)
+	for _, r := range markdownPlacehold.ReplaceAllString(protected, "") {
+		if strings.ContainsRune("-*_=#|>:.,;!?()[]{}<>/\\'\"`~+0123456789 \t\n", r) {
+			continue
+		}
+		return true
+	}
+	return false
+}
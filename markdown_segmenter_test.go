@@ -0,0 +1,154 @@
+package transifex_api_client
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSegmentMarkdownRoundTrip(t *testing.T) {
+	src, err := os.ReadFile("testdata/lesson.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, eol := range []string{"\n", "\r\n"} {
+		input := strings.ReplaceAll(string(src), "\n", eol)
+		doc := SegmentMarkdown(input, MarkdownSegmenterOptions{})
+		output, err := doc.Assemble(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != input {
+			t.Errorf("the assembled file differs from the source (%q line ends):\n%s", eol, output)
+		}
+	}
+}
+
+func TestSegmentMarkdownCallouts(t *testing.T) {
+	src, err := os.ReadFile("testdata/lesson.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := SegmentMarkdown(string(src), MarkdownSegmenterOptions{})
+
+	callouts := map[string]string{}
+	for _, seg := range doc.Segments {
+		if strings.Contains(seg.Text, "{:") {
+			t.Errorf("the attribute list is a part of the segment %q", seg.Text)
+		}
+		callouts[seg.Text] = seg.Callout
+	}
+
+	for text, callout := range map[string]string{
+		"How can I do the same operations on many different values?": "questions",
+		"Counting letters": "challenge",
+		"Use the [<c1/>](https://docs.python.org/3/library/functions.html#len) function.": "challenge/hint",
+		"Repeating actions": "",
+		"Loop variables":    "callout",
+		"Summing a list":    "challenge",
+		"Start with <c1/>":  "challenge",
+		"Solution":          "challenge/solution",
+		"Command":           "",
+	} {
+		got, ok := callouts[text]
+		if !ok {
+			t.Errorf("no segment %q, the segments: %v", text, callouts)
+			continue
+		}
+		if got != callout {
+			t.Errorf("segment %q: callout %q, expected %q", text, got, callout)
+		}
+	}
+}
+
+func TestSegmentMarkdownQuotedBlocks(t *testing.T) {
+	src := "> ## Loop variables {#loops}\n> - Use `i`\n>   for the index\n> ~~~\n> x = 1\n> ~~~\n"
+	doc := SegmentMarkdown(src, MarkdownSegmenterOptions{})
+
+	expected := []struct{ kind, text string }{
+		{MarkdownHeading, "Loop variables"},
+		{MarkdownListItem, "Use <c1/>\n  for the index"},
+	}
+	if len(doc.Segments) != len(expected) {
+		t.Fatalf("%d segments, expected %d: %+v", len(doc.Segments), len(expected), doc.Segments)
+	}
+	for i, e := range expected {
+		if seg := doc.Segments[i]; seg.Kind != e.kind || seg.Text != e.text {
+			t.Errorf("segment %d is %s %q, expected %s %q", i, seg.Kind, seg.Text, e.kind, e.text)
+		}
+	}
+
+	translations := map[string]string{
+		doc.Segments[0].Key: "Змінні циклу",
+		doc.Segments[1].Key: "Використовуйте <c1/>\n  для індексу",
+	}
+	output, err := doc.Assemble(translations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "> ## Змінні циклу {#loops}\n> - Використовуйте `i`\n>   для індексу\n> ~~~\n> x = 1\n> ~~~\n"; output != expected {
+		t.Errorf("assembled file:\n%s\nexpected:\n%s", output, expected)
+	}
+}
+
+func TestMarkdownDocumentAssemble(t *testing.T) {
+	src := "## Loops\n\nUse `for x in items` to repeat {{ site.action }}.\n"
+	doc := SegmentMarkdown(src, MarkdownSegmenterOptions{})
+	if len(doc.Segments) != 2 {
+		t.Fatalf("%d segments, expected 2: %+v", len(doc.Segments), doc.Segments)
+	}
+	heading, para := doc.Segments[0], doc.Segments[1]
+	if para.Text != "Use <c1/> to repeat <c2/>." {
+		t.Fatalf("unexpected segment text %q", para.Text)
+	}
+
+	// The placeholders are replaced with the inline code in the translation
+	output, err := doc.Assemble(map[string]string{
+		heading.Key: "Цикли",
+		para.Key:    "Використовуйте <c1/>, щоб повторити <c2/>.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "## Цикли\n\nВикористовуйте `for x in items`, щоб повторити {{ site.action }}.\n"; output != expected {
+		t.Errorf("assembled file:\n%s\nexpected:\n%s", output, expected)
+	}
+
+	// The translation without a placeholder is rejected, the source text is kept
+	output, err = doc.Assemble(map[string]string{
+		heading.Key: "Цикли",
+		para.Key:    "Використовуйте for x in items, щоб повторити <c2/>.",
+	})
+	if err == nil || !strings.Contains(err.Error(), "<c1/>") {
+		t.Errorf("the missed placeholder is not reported: %v", err)
+	}
+	if expected := "## Цикли\n\nUse `for x in items` to repeat {{ site.action }}.\n"; output != expected {
+		t.Errorf("assembled file:\n%s\nexpected:\n%s", output, expected)
+	}
+}
+
+func TestMarkdownDocumentAssembleFromTranslations(t *testing.T) {
+	src := "Run `python`.\n\nExit the shell.\n"
+	doc := SegmentMarkdown(src, MarkdownSegmenterOptions{Path: "episodes/shell.md"})
+	strs := doc.ResourceStrings()
+	if len(strs) != 2 {
+		t.Fatalf("%d resource strings, expected 2", len(strs))
+	}
+
+	// The resource strings are pushed to the service, which assigns them new IDs
+	for i := range strs {
+		strs[i].ID = "o:org:p:lesson:r:shell:s:" + strs[i].Attributes.Key
+	}
+	var tr ResourceTranslation
+	tr.Relationships.ResourceString.Data.ID = strs[0].ID
+	tr.Attributes.Strings.Other = "Запустіть <c1/>."
+
+	output, err := doc.AssembleFromTranslations(strs, []ResourceTranslation{tr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Запустіть `python`.\n\nExit the shell.\n"; output != expected {
+		t.Errorf("assembled file:\n%s\nexpected:\n%s", output, expected)
+	}
+}
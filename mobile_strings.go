@@ -0,0 +1,64 @@
+package transifex_api_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// The MobileStringIssue struct describes a problem found by the validation
+// of an Android or iOS strings file
+type MobileStringIssue struct {
+	Key    string `json:"key"`
+	Reason string `json:"reason"`
+}
+
+// The function prints the information about the validation issues of a mobile strings file
+func (t *TransifexApiClient) PrintMobileStringIssues(issues []MobileStringIssue, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Mobile strings validation issues:\n")
+		for _, i := range issues {
+			fmt.Printf("  %v: %v\n", i.Key, i.Reason)
+		}
+
+	case "json":
+		text2print, err := json.Marshal(issues)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the plural forms of a string against the plural rules of the language
+func checkMobilePluralForms(key string, p PluralStrings, lang Language) []MobileStringIssue {
+	var issues []MobileStringIssue
+	if missing := p.MissingForms(lang); len(missing) > 0 {
+		issues = append(issues, MobileStringIssue{
+			Key:    key,
+			Reason: fmt.Sprintf("missed plural forms: %s", strings.Join(missing, ", ")),
+		})
+	}
+	return issues
+}
+
+// The function makes the text safe for an XML comment: the "--" sequences,
+// that are not allowed in the comments, are split with spaces
+func xmlCommentText(c string) string {
+	for strings.Contains(c, "--") {
+		c = strings.ReplaceAll(c, "--", "- -")
+	}
+	return c
+}
+
+// The function makes the text safe for a C-style comment of a .strings file:
+// the "*/" sequences, that would close the comment, are split with a space
+func cCommentText(c string) string {
+	return strings.ReplaceAll(c, "*/", "* /")
+}
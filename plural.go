@@ -0,0 +1,128 @@
+package transifex_api_client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The list of CLDR plural categories in their canonical order
+var PluralCategories = []string{"zero", "one", "two", "few", "many", "other"}
+
+// The PluralStrings struct stores the plural forms of a source string or a translation.
+// Non-pluralized strings have the "other" form only.
+type PluralStrings struct {
+	Zero  string `json:"zero,omitempty"`
+	One   string `json:"one,omitempty"`
+	Two   string `json:"two,omitempty"`
+	Few   string `json:"few,omitempty"`
+	Many  string `json:"many,omitempty"`
+	Other string `json:"other,omitempty"`
+}
+
+// The function returns the value of a plural form by its CLDR category name
+func (p PluralStrings) Get(category string) string {
+	switch category {
+	case "zero":
+		return p.Zero
+	case "one":
+		return p.One
+	case "two":
+		return p.Two
+	case "few":
+		return p.Few
+	case "many":
+		return p.Many
+	case "other":
+		return p.Other
+	default:
+		return ""
+	}
+}
+
+// The function sets the value of a plural form by its CLDR category name.
+// Unknown categories are ignored.
+func (p *PluralStrings) Set(category, value string) {
+	switch category {
+	case "zero":
+		p.Zero = value
+	case "one":
+		p.One = value
+	case "two":
+		p.Two = value
+	case "few":
+		p.Few = value
+	case "many":
+		p.Many = value
+	case "other":
+		p.Other = value
+	}
+}
+
+// The function returns the categories of all non-empty plural forms in the canonical order
+func (p PluralStrings) Categories() []string {
+	var cs []string
+	for _, c := range PluralCategories {
+		if p.Get(c) != "" {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// The function returns the categories of the plural forms required by the language
+// (the ones having a plural rule), in the canonical order.
+// The "other" form is always required.
+func (l Language) RequiredPluralForms() []string {
+	rules := PluralStrings{
+		Zero:  l.Attributes.PluralRules.Zero,
+		One:   l.Attributes.PluralRules.One,
+		Two:   l.Attributes.PluralRules.Two,
+		Few:   l.Attributes.PluralRules.Few,
+		Many:  l.Attributes.PluralRules.Many,
+		Other: l.Attributes.PluralRules.Other,
+	}
+
+	var cs []string
+	for _, c := range PluralCategories {
+		if rules.Get(c) != "" || c == "other" {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// The function returns the plural forms required by the language, but missed in the strings
+func (p PluralStrings) MissingForms(l Language) []string {
+	var missing []string
+	for _, c := range l.RequiredPluralForms() {
+		if p.Get(c) == "" {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// The function checks whether the strings have all the plural forms required by the language
+func (p PluralStrings) HasAllForms(l Language) bool {
+	return len(p.MissingForms(l)) == 0
+}
+
+// The function returns the plural forms of the translation required by the target language,
+// but missed in the translation. For non-pluralized source strings only the "other" form
+// is required.
+func (r ResourceTranslation) MissingPluralForms(s ResourceString, l Language) []string {
+	if !s.Attributes.Pluralized {
+		if r.Attributes.Strings.Other == "" {
+			return []string{"other"}
+		}
+		return nil
+	}
+	return r.Attributes.Strings.MissingForms(l)
+}
+
+// The function prints the non-empty plural forms with the given indentation
+func printPluralStrings(p PluralStrings, indent string) {
+	for _, c := range p.Categories() {
+		fmt.Printf("%s%s: %v\n", indent, strings.ToUpper(c[:1])+c[1:], p.Get(c))
+	}
+}
@@ -0,0 +1,434 @@
+package transifex_api_client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The PluralEquation struct stores a compiled plural equation of a language
+// (a C-like expression of the variable n, as used in gettext Plural-Forms headers).
+// The equation is parsed into an AST once and can be evaluated safely for any n.
+type PluralEquation struct {
+	source     string
+	root       pluralNode
+	categories []string
+}
+
+// A node of the plural equation AST
+type pluralNode interface {
+	eval(n int64) int64
+}
+
+type pluralNumber struct {
+	value int64
+}
+
+type pluralVariable struct{}
+
+type pluralUnary struct {
+	op      string
+	operand pluralNode
+}
+
+type pluralBinary struct {
+	op          string
+	left, right pluralNode
+}
+
+type pluralTernary struct {
+	cond, then, otherwise pluralNode
+}
+
+func (p pluralNumber) eval(n int64) int64 {
+	return p.value
+}
+
+func (p pluralVariable) eval(n int64) int64 {
+	return n
+}
+
+func (p pluralUnary) eval(n int64) int64 {
+	v := p.operand.eval(n)
+	switch p.op {
+	case "!":
+		return boolToInt(v == 0)
+	case "-":
+		return -v
+	default:
+		return v
+	}
+}
+
+func (p pluralBinary) eval(n int64) int64 {
+
+	// Evaluate the logical operators lazily
+	switch p.op {
+	case "&&":
+		return boolToInt(p.left.eval(n) != 0 && p.right.eval(n) != 0)
+	case "||":
+		return boolToInt(p.left.eval(n) != 0 || p.right.eval(n) != 0)
+	}
+
+	l, r := p.left.eval(n), p.right.eval(n)
+	switch p.op {
+	case "+":
+		return l + r
+	case "-":
+		return l - r
+	case "*":
+		return l * r
+	case "/":
+		if r == 0 {
+			return 0
+		}
+		return l / r
+	case "%":
+		if r == 0 {
+			return 0
+		}
+		return l % r
+	case "==":
+		return boolToInt(l == r)
+	case "!=":
+		return boolToInt(l != r)
+	case "<":
+		return boolToInt(l < r)
+	case "<=":
+		return boolToInt(l <= r)
+	case ">":
+		return boolToInt(l > r)
+	case ">=":
+		return boolToInt(l >= r)
+	default:
+		return 0
+	}
+}
+
+func (p pluralTernary) eval(n int64) int64 {
+	if p.cond.eval(n) != 0 {
+		return p.then.eval(n)
+	}
+	return p.otherwise.eval(n)
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// The function compiles a plural equation, e.g. "(n != 1)" or
+// "n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2".
+// The "nplurals=N; plural=" prefix of a gettext header is accepted as well.
+func CompilePluralEquation(equation string) (*PluralEquation, error) {
+
+	// Strip the gettext header parts, if any
+	expr := strings.TrimSpace(equation)
+	if i := strings.Index(expr, "plural="); i >= 0 {
+		expr = expr[i+len("plural="):]
+	}
+	expr = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(expr), ";"))
+
+	if expr == "" {
+		return nil, fmt.Errorf("empty plural equation")
+	}
+
+	tokens, err := tokenizePluralEquation(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pluralParser{tokens: tokens}
+	root, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token '%s' in the plural equation", p.tokens[p.pos])
+	}
+
+	return &PluralEquation{source: expr, root: root}, nil
+}
+
+// The function compiles the plural equation of a language.
+// The results of the equation are mapped to the plural categories required by the language.
+func (l Language) CompilePluralEquation() (*PluralEquation, error) {
+	pe, err := CompilePluralEquation(l.Attributes.PluralEquation)
+	if err != nil {
+		return nil, fmt.Errorf("language '%s': %s", l.Attributes.Code, err.Error())
+	}
+	pe.categories = l.RequiredPluralForms()
+	return pe, nil
+}
+
+// The function returns the index of the plural form for the number n
+func (pe *PluralEquation) Evaluate(n int) int {
+	return int(pe.root.eval(int64(n)))
+}
+
+// The function returns the CLDR plural category for the number n.
+// If the equation was not compiled from a language, the function returns "other".
+func (pe *PluralEquation) Category(n int) string {
+	i := pe.Evaluate(n)
+	if i < 0 || i >= len(pe.categories) {
+		return "other"
+	}
+	return pe.categories[i]
+}
+
+// The function returns the number of the plural forms of the equation, i.e. the maximum
+// index it can return plus one. The index is found in the AST: the numbers returned
+// by the ternary branches or 1 for a comparison, like in "(n != 1)".
+// The equations returning arithmetic expressions are evaluated for n from 0 to 1000.
+func (pe *PluralEquation) Forms() int {
+	if max, ok := pluralMaxIndex(pe.root); ok {
+		return int(max) + 1
+	}
+
+	max := 0
+	for n := 0; n <= 1000; n++ {
+		if i := pe.Evaluate(n); i > max {
+			max = i
+		}
+	}
+	return max + 1
+}
+
+// The function returns the maximum value of the node, if it can be found without evaluation
+func pluralMaxIndex(node pluralNode) (int64, bool) {
+	switch p := node.(type) {
+	case pluralNumber:
+		return p.value, true
+	case pluralTernary:
+		then, ok := pluralMaxIndex(p.then)
+		if !ok {
+			return 0, false
+		}
+		otherwise, ok := pluralMaxIndex(p.otherwise)
+		if !ok {
+			return 0, false
+		}
+		if then > otherwise {
+			return then, true
+		}
+		return otherwise, true
+	case pluralUnary:
+		if p.op == "!" {
+			return 1, true
+		}
+	case pluralBinary:
+		switch p.op {
+		case "==", "!=", "<", "<=", ">", ">=", "&&", "||":
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+// The function returns the source of the compiled equation
+func (pe *PluralEquation) String() string {
+	return pe.source
+}
+
+// The function returns the value of the gettext Plural-Forms header for the language,
+// e.g. "nplurals=2; plural=(n != 1);". The number of the forms is taken from the equation;
+// an error is returned, if it differs from the number of the plural rules of the language.
+func (l Language) PluralFormsHeader() (string, error) {
+	pe, err := l.CompilePluralEquation()
+	if err != nil {
+		return "", err
+	}
+
+	nplurals := pe.Forms()
+	if nplurals != len(pe.categories) {
+		return "", fmt.Errorf("language '%s': the plural equation has %d forms, but %d plural rules are defined",
+			l.Attributes.Code, nplurals, len(pe.categories))
+	}
+	return fmt.Sprintf("nplurals=%d; plural=%s;", nplurals, pe.source), nil
+}
+
+// The function splits a plural equation into tokens
+func tokenizePluralEquation(expr string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(expr) && expr[j] >= '0' && expr[j] <= '9' {
+				j++
+			}
+			tokens = append(tokens, expr[i:j])
+			i = j
+
+		case c == 'n':
+			tokens = append(tokens, "n")
+			i++
+
+		case strings.ContainsRune("=!<>&|", rune(c)) && i+1 < len(expr) &&
+			isPluralOperator(expr[i:i+2]):
+			tokens = append(tokens, expr[i:i+2])
+			i += 2
+
+		case strings.ContainsRune("+-*/%<>!?:()", rune(c)):
+			tokens = append(tokens, string(c))
+			i++
+
+		default:
+			return nil, fmt.Errorf("unexpected symbol '%c' in the plural equation", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func isPluralOperator(s string) bool {
+	switch s {
+	case "==", "!=", "<=", ">=", "&&", "||":
+		return true
+	}
+	return false
+}
+
+// A recursive descent parser of plural equations following the C operators precedence
+type pluralParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *pluralParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *pluralParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+// ternary := or ( "?" ternary ":" ternary )?
+func (p *pluralParser) parseTernary() (pluralNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() != "?" {
+		return cond, nil
+	}
+	p.next()
+
+	then, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.next() != ":" {
+		return nil, fmt.Errorf("missed ':' in the plural equation")
+	}
+
+	otherwise, err := p.parseTernary()
+	if err != nil {
+		return nil, err
+	}
+
+	return pluralTernary{cond: cond, then: then, otherwise: otherwise}, nil
+}
+
+// The binary operators grouped by precedence, from the lowest to the highest one
+var pluralPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// The function parses left-associative binary operators of the given precedence level
+func (p *pluralParser) parseBinary(level int) (pluralNode, error) {
+	if level == len(pluralPrecedence) {
+		return p.parseUnary()
+	}
+
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek()
+		found := false
+		for _, o := range pluralPrecedence[level] {
+			if op == o {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return left, nil
+		}
+		p.next()
+
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = pluralBinary{op: op, left: left, right: right}
+	}
+}
+
+// unary := ( "!" | "-" | "+" ) unary | primary
+func (p *pluralParser) parseUnary() (pluralNode, error) {
+	switch op := p.peek(); op {
+	case "!", "-", "+":
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return pluralUnary{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+// primary := "n" | number | "(" ternary ")"
+func (p *pluralParser) parsePrimary() (pluralNode, error) {
+	t := p.next()
+	switch {
+	case t == "":
+		return nil, fmt.Errorf("unexpected end of the plural equation")
+
+	case t == "n":
+		return pluralVariable{}, nil
+
+	case t[0] >= '0' && t[0] <= '9':
+		v, err := strconv.ParseInt(t, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number '%s' in the plural equation", t)
+		}
+		return pluralNumber{value: v}, nil
+
+	case t == "(":
+		node, err := p.parseTernary()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missed ')' in the plural equation")
+		}
+		return node, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected token '%s' in the plural equation", t)
+	}
+}
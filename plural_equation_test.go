@@ -0,0 +1,82 @@
+package transifex_api_client
+
+import "testing"
+
+// The function creates a language with the plural equation and the plural rules of the categories
+func newTestLanguage(code, equation string, categories ...string) Language {
+	var l Language
+	l.ID = "l:" + code
+	l.Attributes.Code = code
+	l.Attributes.PluralEquation = equation
+	rules := PluralStrings{}
+	for _, c := range categories {
+		rules.Set(c, "rule")
+	}
+	l.Attributes.PluralRules.Zero = rules.Zero
+	l.Attributes.PluralRules.One = rules.One
+	l.Attributes.PluralRules.Two = rules.Two
+	l.Attributes.PluralRules.Few = rules.Few
+	l.Attributes.PluralRules.Many = rules.Many
+	l.Attributes.PluralRules.Other = rules.Other
+	return l
+}
+
+var testPluralLanguages = map[string]Language{
+	"en": newTestLanguage("en", "(n != 1)", "one", "other"),
+	"uk": newTestLanguage("uk",
+		"(n % 1 == 0 && n % 10 == 1 && n % 100 != 11 ? 0 : n % 1 == 0 && n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : n % 1 == 0 && (n % 10 ==0 || (n % 10 >=5 && n % 10 <=9) || (n % 100 >=11 && n % 100 <=14 )) ? 2: 3)",
+		"one", "few", "many", "other"),
+	"ru": newTestLanguage("ru",
+		"(n % 1 == 0 && n % 10 == 1 && n % 100 != 11 ? 0 : n % 1 == 0 && n % 10 >= 2 && n % 10 <= 4 && (n % 100 < 12 || n % 100 > 14) ? 1 : n % 1 == 0 && (n % 10 ==0 || (n % 10 >=5 && n % 10 <=9) || (n % 100 >=11 && n % 100 <=14 )) ? 2: 3)",
+		"one", "few", "many", "other"),
+	"pl": newTestLanguage("pl",
+		"(n==1 ? 0 : (n%10>=2 && n%10<=4) && (n%100<12 || n%100>14) ? 1 : n!=1 && (n%10>=0 && n%10<=1) || (n%10>=5 && n%10<=9) || (n%100>=12 && n%100<=14) ? 2 : 3)",
+		"one", "few", "many", "other"),
+	"ar": newTestLanguage("ar",
+		"(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 && n%100<=99 ? 4 : 5)",
+		"zero", "one", "two", "few", "many", "other"),
+}
+
+func TestPluralEquationCategory(t *testing.T) {
+	numbers := []int{0, 1, 2, 3, 5, 11, 21, 22, 25, 101, 111}
+	expected := map[string][]string{
+		"en": {"other", "one", "other", "other", "other", "other", "other", "other", "other", "other", "other"},
+		"uk": {"many", "one", "few", "few", "many", "many", "one", "few", "many", "one", "many"},
+		"ru": {"many", "one", "few", "few", "many", "many", "one", "few", "many", "one", "many"},
+		"pl": {"many", "one", "few", "few", "many", "many", "many", "few", "many", "many", "many"},
+		"ar": {"zero", "one", "two", "few", "few", "many", "many", "many", "many", "other", "many"},
+	}
+
+	for code, categories := range expected {
+		pe, err := testPluralLanguages[code].CompilePluralEquation()
+		if err != nil {
+			t.Fatalf("%s: %v", code, err)
+		}
+		for i, n := range numbers {
+			if got := pe.Category(n); got != categories[i] {
+				t.Errorf("%s: Category(%d) = %q, expected %q", code, n, got, categories[i])
+			}
+		}
+	}
+}
+
+func TestPluralFormsHeader(t *testing.T) {
+	for code, nplurals := range map[string]int{"en": 2, "uk": 4, "ru": 4, "pl": 4, "ar": 6} {
+		pe, err := testPluralLanguages[code].CompilePluralEquation()
+		if err != nil {
+			t.Fatalf("%s: %v", code, err)
+		}
+		if got := pe.Forms(); got != nplurals {
+			t.Errorf("%s: Forms() = %d, expected %d", code, got, nplurals)
+		}
+		if _, err := testPluralLanguages[code].PluralFormsHeader(); err != nil {
+			t.Errorf("%s: %v", code, err)
+		}
+	}
+
+	// The equation returning 0 or 1 does not match three plural rules
+	l := newTestLanguage("xx", "(n > 1)", "one", "many", "other")
+	if _, err := l.PluralFormsHeader(); err == nil {
+		t.Errorf("the mismatch of the plural forms number is not detected")
+	}
+}
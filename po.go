@@ -0,0 +1,299 @@
+package transifex_api_client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The POHeader struct stores a field of the PO file header (the msgstr of the empty msgid)
+type POHeader struct {
+	Name  string
+	Value string
+}
+
+// The POEntry struct stores a single message of a PO file
+type POEntry struct {
+	TranslatorComments []string // "# " lines
+	ExtractedComments  []string // "#. " lines
+	References         []string // "#: " lines, e.g. "episodes/intro.md:12"
+	Flags              []string // "#, " lines, e.g. "fuzzy"
+	Context            string   // msgctxt
+	ID                 string   // msgid
+	IDPlural           string   // msgid_plural
+	Str                []string // msgstr (a single value) or msgstr[n] (for plural messages)
+	Obsolete           bool     // "#~ " entries
+}
+
+// The POFile struct stores the header and the messages of a PO (or POT) file
+type POFile struct {
+	Headers []POHeader
+	Entries []POEntry
+}
+
+// The options of the PO file export
+type POExportOptions struct {
+	Project         string    // the value of the Project-Id-Version header
+	Date            time.Time // the creation date of the file (the current time, if not set)
+	FuzzyUnreviewed bool      // mark the translated, but not reviewed messages as fuzzy
+}
+
+// The function checks whether the entry has the "fuzzy" flag
+func (e POEntry) IsFuzzy() bool {
+	return e.HasFlag("fuzzy")
+}
+
+// The function checks whether the entry has the flag
+func (e POEntry) HasFlag(flag string) bool {
+	for _, f := range e.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// The function checks whether the entry is a plural message
+func (e POEntry) IsPlural() bool {
+	return e.IDPlural != ""
+}
+
+// The function returns the value of a header field (an empty string, if not found)
+func (f POFile) Header(name string) string {
+	for _, h := range f.Headers {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+// The function creates a PO file of the resource strings translated into the language.
+// The plural forms of the translations are ordered according to the Plural-Forms header
+// of the language. The strings without translations are exported with empty msgstr.
+func NewPOFile(strs []ResourceString, trs []ResourceTranslation, lang Language, opts POExportOptions) (POFile, error) {
+
+	pluralForms, err := lang.PluralFormsHeader()
+	if err != nil {
+		return POFile{}, err
+	}
+	categories := lang.RequiredPluralForms()
+
+	// Index the translations by the resource string ID
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	f := POFile{Headers: newPOHeaders(opts, lang.Attributes.Code, pluralForms)}
+	for _, s := range sortResourceStrings(strs) {
+		e := newPOEntry(s)
+		tr, translated := translations[s.ID]
+
+		if e.IsPlural() {
+			e.Str = make([]string, len(categories))
+			if translated {
+				for i, c := range categories {
+					e.Str[i] = tr.Attributes.Strings.Get(c)
+				}
+			}
+		} else {
+			e.Str = []string{""}
+			if translated {
+				e.Str[0] = tr.Attributes.Strings.Other
+			}
+		}
+
+		// Map the review status onto the fuzzy flag
+		if translated && opts.FuzzyUnreviewed && !tr.Attributes.Reviewed && tr.Attributes.Strings.Other != "" {
+			e.Flags = append(e.Flags, "fuzzy")
+		}
+
+		f.Entries = append(f.Entries, e)
+	}
+
+	return f, nil
+}
+
+// The function creates a PO template (POT) file of the resource strings
+func NewPOTFile(strs []ResourceString, opts POExportOptions) POFile {
+	f := POFile{Headers: newPOHeaders(opts, "", "nplurals=INTEGER; plural=EXPRESSION;")}
+	for _, s := range sortResourceStrings(strs) {
+		e := newPOEntry(s)
+		if e.IsPlural() {
+			e.Str = []string{"", ""}
+		} else {
+			e.Str = []string{""}
+		}
+		f.Entries = append(f.Entries, e)
+	}
+	return f
+}
+
+// The function writes the PO file
+func (f POFile) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	// Write the header
+	var header strings.Builder
+	for _, h := range f.Headers {
+		header.WriteString(h.Name + ": " + h.Value + "\n")
+	}
+	writePOEntry(bw, POEntry{Str: []string{header.String()}})
+
+	// Write the messages (the obsolete ones go last)
+	for _, obsolete := range []bool{false, true} {
+		for _, e := range f.Entries {
+			if e.Obsolete != obsolete {
+				continue
+			}
+			bw.WriteString("\n")
+			writePOEntry(bw, e)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// The function returns the header fields of an exported PO file
+func newPOHeaders(opts POExportOptions, language, pluralForms string) []POHeader {
+	date := opts.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	project := opts.Project
+	if project == "" {
+		project = "PACKAGE VERSION"
+	}
+
+	return []POHeader{
+		{"Project-Id-Version", project},
+		{"POT-Creation-Date", date.Format("2006-01-02 15:04-0700")},
+		{"Language", language},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=UTF-8"},
+		{"Content-Transfer-Encoding", "8bit"},
+		{"Plural-Forms", pluralForms},
+	}
+}
+
+// The function converts a resource string into a PO entry without translations.
+// The string key is stored in msgctxt, when the string has no context
+// and the key differs from the source text.
+func newPOEntry(s ResourceString) POEntry {
+	a := s.Attributes
+	e := POEntry{
+		Context: a.Context,
+		ID:      a.Strings.Other,
+	}
+
+	if a.Pluralized {
+		e.ID = a.Strings.One
+		if e.ID == "" {
+			e.ID = a.Strings.Other
+		}
+		e.IDPlural = a.Strings.Other
+	}
+
+	if e.Context == "" && a.Key != e.ID {
+		e.Context = a.Key
+	}
+
+	for _, c := range []string{a.DeveloperComment, a.Instructions} {
+		if c != "" {
+			e.ExtractedComments = append(e.ExtractedComments, strings.Split(c, "\n")...)
+		}
+	}
+	if a.CharacterLimit > 0 {
+		e.ExtractedComments = append(e.ExtractedComments, fmt.Sprintf("Character limit: %d", a.CharacterLimit))
+	}
+
+	e.References = strings.FieldsFunc(a.Occurrences, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+
+	return e
+}
+
+// The function returns the resource strings in their appearance order
+func sortResourceStrings(strs []ResourceString) []ResourceString {
+	sorted := make([]ResourceString, len(strs))
+	copy(sorted, strs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Attributes.AppearanceOrder < sorted[j].Attributes.AppearanceOrder
+	})
+	return sorted
+}
+
+// The function writes a single PO entry
+func writePOEntry(w *bufio.Writer, e POEntry) {
+	prefix := ""
+	if e.Obsolete {
+		prefix = "#~ "
+	}
+
+	for _, c := range e.TranslatorComments {
+		w.WriteString(strings.TrimRight("# "+c, " ") + "\n")
+	}
+	for _, c := range e.ExtractedComments {
+		w.WriteString(strings.TrimRight("#. "+c, " ") + "\n")
+	}
+	if len(e.References) > 0 {
+		w.WriteString("#: " + strings.Join(e.References, " ") + "\n")
+	}
+	if len(e.Flags) > 0 {
+		w.WriteString("#, " + strings.Join(e.Flags, ", ") + "\n")
+	}
+
+	if e.Context != "" {
+		writePOString(w, prefix, "msgctxt", e.Context)
+	}
+	writePOString(w, prefix, "msgid", e.ID)
+
+	if e.IsPlural() {
+		writePOString(w, prefix, "msgid_plural", e.IDPlural)
+		for i, s := range e.Str {
+			writePOString(w, prefix, fmt.Sprintf("msgstr[%d]", i), s)
+		}
+		return
+	}
+
+	str := ""
+	if len(e.Str) > 0 {
+		str = e.Str[0]
+	}
+	writePOString(w, prefix, "msgstr", str)
+}
+
+// The function writes a keyword with a quoted string value.
+// Multi-line values are split into several lines after each newline.
+func writePOString(w *bufio.Writer, prefix, keyword, value string) {
+	lines := strings.SplitAfter(value, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) <= 1 {
+		w.WriteString(prefix + keyword + " \"" + escapePOString(value) + "\"\n")
+		return
+	}
+
+	w.WriteString(prefix + keyword + " \"\"\n")
+	for _, l := range lines {
+		w.WriteString(prefix + "\"" + escapePOString(l) + "\"\n")
+	}
+}
+
+// The function escapes the special characters of a PO string
+func escapePOString(s string) string {
+	return strings.NewReplacer(
+		"\\", "\\\\",
+		"\"", "\\\"",
+		"\n", "\\n",
+		"\r", "\\r",
+		"\t", "\\t",
+	).Replace(s)
+}
@@ -0,0 +1,456 @@
+package transifex_api_client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The options of the PO file import
+type POImportOptions struct {
+	IncludeFuzzy bool // import the fuzzy messages as well (they are skipped by default)
+	MarkReviewed bool // mark the imported (non-fuzzy) translations as reviewed
+}
+
+// The POEntryIssue struct describes a PO entry, that was not converted into a translation update
+type POEntryIssue struct {
+	Entry          POEntry
+	ResourceString string // the ID of the matched resource string (if any)
+	Reason         string
+}
+
+// The POImportReport struct stores the result of matching a PO file against the resource strings
+type POImportReport struct {
+	Updates      []TranslationUpdate
+	Unmatched    []POEntryIssue // the entries without a matching resource string
+	Conflicts    []POEntryIssue // the entries, that match a resource string, but cannot be imported safely
+	Untranslated int            // the number of skipped entries without translation
+	Fuzzy        int            // the number of skipped fuzzy entries
+	Obsolete     int            // the number of skipped obsolete entries
+	Unchanged    int            // the number of entries equal to the current translations
+}
+
+// The function parses a PO (or POT) file
+func ParsePO(r io.Reader) (POFile, error) {
+	p := poParser{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for sc.Scan() {
+		p.line++
+		if err := p.parseLine(sc.Text()); err != nil {
+			return POFile{}, fmt.Errorf("PO file line %d: %s", p.line, err.Error())
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return POFile{}, err
+	}
+	p.flush()
+
+	return p.file, nil
+}
+
+// The function matches the PO file entries to the resource strings and converts them
+// into translation updates for the language. The entries are matched by the context
+// and the source text, or by msgctxt (the key or the hash of a string without context).
+// The current translations (if given) are used to skip the unchanged entries
+// and to detect the overwrites of reviewed translations.
+func MatchPOFile(f POFile, strs []ResourceString, trs []ResourceTranslation, lang Language, opts POImportOptions) (POImportReport, error) {
+	var report POImportReport
+	categories := lang.RequiredPluralForms()
+
+	// Check the plural forms of the file
+	if n := poPluralsNumber(f.Header("Plural-Forms")); n > 0 && n != len(categories) {
+		return report, fmt.Errorf("the PO file has %d plural forms, but language '%s' requires %d",
+			n, lang.Attributes.Code, len(categories))
+	}
+
+	// Index the resource strings and the translations
+	byKey := map[string]*ResourceString{}
+	bySource := map[string][]*ResourceString{}
+	for i := range strs {
+		s := &strs[i]
+		byKey[s.Attributes.Key] = s
+		if s.Attributes.StringHash != "" {
+			byKey[s.Attributes.StringHash] = s
+		}
+		src := s.Attributes.Context + "\x04" + poSourceID(*s)
+		bySource[src] = append(bySource[src], s)
+	}
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	matched := map[string]bool{}
+	for _, e := range f.Entries {
+
+		// Skip the entries, that should not be imported
+		switch {
+		case e.Obsolete:
+			report.Obsolete++
+			continue
+		case e.IsFuzzy() && !opts.IncludeFuzzy:
+			report.Fuzzy++
+			continue
+		case !poEntryTranslated(e):
+			report.Untranslated++
+			continue
+		}
+
+		// Find the resource string by the context and the source text
+		var s *ResourceString
+		candidates := bySource[e.Context+"\x04"+e.ID]
+		if len(candidates) > 1 {
+			report.Conflicts = append(report.Conflicts, POEntryIssue{e, "", "the source text matches several resource strings"})
+			continue
+		}
+		if len(candidates) == 1 {
+			s = candidates[0]
+		}
+
+		// The export writes the key into msgctxt for the strings without context only,
+		// so the key is trusted, if the string has no context and the same source text
+		if k := byKey[e.Context]; s == nil && e.Context != "" && k != nil && k.Attributes.Context == "" {
+			if e.ID != poSourceID(*k) {
+				report.Conflicts = append(report.Conflicts, POEntryIssue{e, k.ID, "the source text has changed"})
+				continue
+			}
+			s = k
+		}
+		if s == nil {
+			report.Unmatched = append(report.Unmatched, POEntryIssue{e, "", "no resource string with the key or the source text"})
+			continue
+		}
+
+		// Check the entry against the resource string
+		conflict := func(reason string) {
+			report.Conflicts = append(report.Conflicts, POEntryIssue{e, s.ID, reason})
+		}
+		if matched[s.ID] {
+			conflict("the resource string is matched by several entries")
+			continue
+		}
+		matched[s.ID] = true
+
+		if e.ID != poSourceID(*s) {
+			conflict("the source text has changed")
+			continue
+		}
+		if e.IsPlural() != s.Attributes.Pluralized {
+			conflict("the plural mode differs from the resource string")
+			continue
+		}
+
+		// Convert the entry into the translation strings
+		var ps PluralStrings
+		if e.IsPlural() {
+			if len(e.Str) != len(categories) {
+				conflict(fmt.Sprintf("the entry has %d plural forms instead of %d", len(e.Str), len(categories)))
+				continue
+			}
+			for i, c := range categories {
+				ps.Set(c, e.Str[i])
+			}
+			if missing := ps.MissingForms(lang); len(missing) > 0 {
+				conflict(fmt.Sprintf("the plural forms %v are missed", missing))
+				continue
+			}
+		} else {
+			ps.Other = e.Str[0]
+		}
+
+		// Compare with the current translation
+		if tr, ok := translations[s.ID]; ok {
+			if tr.Attributes.Strings == ps {
+				report.Unchanged++
+				continue
+			}
+			if tr.Attributes.Reviewed && tr.Attributes.Strings.Other != "" {
+				conflict("the entry overwrites a reviewed translation")
+				continue
+			}
+		}
+
+		report.Updates = append(report.Updates, TranslationUpdate{
+			ResourceString: s.ID,
+			Key:            s.Attributes.Key,
+			Language:       lang.ID,
+			Strings:        ps,
+			Reviewed:       opts.MarkReviewed && !e.IsFuzzy(),
+		})
+	}
+
+	return report, nil
+}
+
+// The function returns the msgid of a resource string, as written by the PO export
+func poSourceID(s ResourceString) string {
+	if s.Attributes.Pluralized && s.Attributes.Strings.One != "" {
+		return s.Attributes.Strings.One
+	}
+	return s.Attributes.Strings.Other
+}
+
+// The function checks whether all the msgstr values of the entry are set
+func poEntryTranslated(e POEntry) bool {
+	if len(e.Str) == 0 {
+		return false
+	}
+	for _, s := range e.Str {
+		if s == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// The function extracts the number of plural forms from the Plural-Forms header (0, if unknown)
+func poPluralsNumber(header string) int {
+	for _, part := range strings.Split(header, ";") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok && strings.TrimSpace(name) == "nplurals" {
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err == nil {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+// The state of the PO file parser
+type poParser struct {
+	file    POFile
+	entry   POEntry
+	line    int
+	started bool    // the current entry has any content
+	hasID   bool    // the msgid of the current entry was read
+	target  *string // the string, that is continued by the quoted lines
+}
+
+// The function processes a single line of a PO file
+func (p *poParser) parseLine(line string) error {
+	line = strings.TrimSpace(line)
+
+	// An empty line ends the entry
+	if line == "" {
+		p.flush()
+		return nil
+	}
+
+	// Obsolete entries have all their lines prefixed with "#~"
+	obsolete := false
+	if strings.HasPrefix(line, "#~") {
+		obsolete = true
+		line = strings.TrimSpace(line[2:])
+		if strings.HasPrefix(line, "|") {
+			// The previous msgid of an obsolete entry
+			return nil
+		}
+		if line == "" {
+			return nil
+		}
+	}
+
+	// Comments
+	if strings.HasPrefix(line, "#") {
+		if p.hasID {
+			p.flush()
+		}
+		p.started = true
+		p.target = nil
+
+		switch {
+		case strings.HasPrefix(line, "#."):
+			p.entry.ExtractedComments = append(p.entry.ExtractedComments, strings.TrimSpace(line[2:]))
+		case strings.HasPrefix(line, "#:"):
+			p.entry.References = append(p.entry.References, strings.Fields(line[2:])...)
+		case strings.HasPrefix(line, "#,"):
+			for _, f := range strings.Split(line[2:], ",") {
+				if f = strings.TrimSpace(f); f != "" {
+					p.entry.Flags = append(p.entry.Flags, f)
+				}
+			}
+		case strings.HasPrefix(line, "#|"):
+			// The previous msgid of a fuzzy entry is not kept
+		default:
+			p.entry.TranslatorComments = append(p.entry.TranslatorComments, strings.TrimPrefix(line[1:], " "))
+		}
+		return nil
+	}
+
+	// Continuation of a multi-line string
+	if strings.HasPrefix(line, "\"") {
+		if p.target == nil {
+			return fmt.Errorf("unexpected string")
+		}
+		s, err := unquotePOString(line)
+		if err != nil {
+			return err
+		}
+		*p.target += s
+		return nil
+	}
+
+	// Keywords
+	keyword, rest, _ := strings.Cut(line, " ")
+	value, err := unquotePOString(strings.TrimSpace(rest))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case keyword == "msgctxt":
+		if p.hasID {
+			p.flush()
+		}
+		p.entry.Context = value
+		p.target = &p.entry.Context
+
+	case keyword == "msgid":
+		if p.hasID {
+			p.flush()
+		}
+		p.entry.ID = value
+		p.hasID = true
+		p.target = &p.entry.ID
+
+	case keyword == "msgid_plural":
+		if !p.hasID {
+			return fmt.Errorf("msgid_plural without msgid")
+		}
+		p.entry.IDPlural = value
+		p.target = &p.entry.IDPlural
+
+	case keyword == "msgstr":
+		if !p.hasID {
+			return fmt.Errorf("msgstr without msgid")
+		}
+		p.entry.Str = append(p.entry.Str, value)
+		p.target = &p.entry.Str[len(p.entry.Str)-1]
+
+	case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+		if !p.hasID {
+			return fmt.Errorf("%s without msgid", keyword)
+		}
+		n, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+		if err != nil || n != len(p.entry.Str) {
+			return fmt.Errorf("unexpected plural form index in '%s'", keyword)
+		}
+		p.entry.Str = append(p.entry.Str, value)
+		p.target = &p.entry.Str[len(p.entry.Str)-1]
+
+	default:
+		return fmt.Errorf("unknown keyword '%s'", keyword)
+	}
+
+	p.started = true
+	p.entry.Obsolete = p.entry.Obsolete || obsolete
+	return nil
+}
+
+// The function completes the current entry and adds it to the file
+func (p *poParser) flush() {
+	if p.started && p.hasID {
+		e := p.entry
+		if e.ID == "" && e.Context == "" && !e.Obsolete && len(p.file.Headers) == 0 && len(p.file.Entries) == 0 {
+			p.file.Headers = parsePOHeaders(strings.Join(e.Str, ""))
+		} else {
+			p.file.Entries = append(p.file.Entries, e)
+		}
+	}
+
+	p.entry = POEntry{}
+	p.started = false
+	p.hasID = false
+	p.target = nil
+}
+
+// The function parses the header fields of a PO file
+func parsePOHeaders(s string) []POHeader {
+	var headers []POHeader
+	for _, line := range strings.Split(s, "\n") {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		headers = append(headers, POHeader{strings.TrimSpace(name), strings.TrimSpace(value)})
+	}
+	return headers
+}
+
+// The function unquotes a PO string and replaces the escape sequences
+func unquotePOString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("invalid string %s", s)
+	}
+	s = s[1 : len(s)-1]
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '"' {
+			return "", fmt.Errorf("unescaped quote in the string")
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+
+		i++
+		if i >= len(s) {
+			return "", fmt.Errorf("unterminated escape sequence")
+		}
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case 'r':
+			b.WriteByte('\r')
+		case 'a':
+			b.WriteByte('\a')
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'v':
+			b.WriteByte('\v')
+		case '\\', '"', '\'', '?':
+			b.WriteByte(s[i])
+		case 'x':
+			j := i + 1
+			for j < len(s) && j < i+3 && isHexDigit(s[j]) {
+				j++
+			}
+			if j == i+1 {
+				return "", fmt.Errorf("invalid hexadecimal escape sequence")
+			}
+			v, _ := strconv.ParseUint(s[i+1:j], 16, 8)
+			b.WriteByte(byte(v))
+			i = j - 1
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			v, err := strconv.ParseUint(s[i:j], 8, 8)
+			if err != nil {
+				return "", fmt.Errorf("invalid octal escape sequence")
+			}
+			b.WriteByte(byte(v))
+			i = j - 1
+		default:
+			return "", fmt.Errorf("unknown escape sequence '\\%c'", s[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// The function checks whether the character is a hexadecimal digit
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
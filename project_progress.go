@@ -0,0 +1,215 @@
+package transifex_api_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// The ProgressStats struct stores the aggregated statistics of a set of resource languages.
+// The percentages are calculated both on strings and on words (from 0 to 100).
+type ProgressStats struct {
+	TotalStrings           int       `json:"total_strings"`
+	TranslatedStrings      int       `json:"translated_strings"`
+	ReviewedStrings        int       `json:"reviewed_strings"`
+	ProofreadStrings       int       `json:"proofread_strings"`
+	UntranslatedStrings    int       `json:"untranslated_strings"`
+	TotalWords             int       `json:"total_words"`
+	TranslatedWords        int       `json:"translated_words"`
+	ReviewedWords          int       `json:"reviewed_words"`
+	ProofreadWords         int       `json:"proofread_words"`
+	UntranslatedWords      int       `json:"untranslated_words"`
+	TranslatedPercent      float64   `json:"translated_percent"`
+	ReviewedPercent        float64   `json:"reviewed_percent"`
+	ProofreadPercent       float64   `json:"proofread_percent"`
+	TranslatedWordsPercent float64   `json:"translated_words_percent"`
+	ReviewedWordsPercent   float64   `json:"reviewed_words_percent"`
+	ProofreadWordsPercent  float64   `json:"proofread_words_percent"`
+	LastUpdate             time.Time `json:"last_update"`
+}
+
+// The ProjectProgressReport struct stores the statistics of a project
+// aggregated per language, per resource and per resource language
+type ProjectProgressReport struct {
+	Project   string                              `json:"project"`
+	Total     ProgressStats                       `json:"total"`
+	Languages map[string]ProgressStats            `json:"languages"`
+	Resources map[string]ProgressStats            `json:"resources"`
+	Details   map[string]map[string]ProgressStats `json:"details"` // resource -> language -> stats
+}
+
+// Get the translation progress of a project.
+// The function goes through all the pages of the resource language statistics
+// and aggregates them per language and per resource.
+func (t *TransifexApiClient) ProjectProgress(ctx context.Context, project string) (ProjectProgressReport, error) {
+
+	params := GetResourceLanguageStatsCollectionParameters{
+		Project: project,
+	}
+
+	// Collect the statistics page by page
+	var stats []ResourseLanguageStat
+	for {
+		page, next, err := t.getResourceLanguageStatsCollectionPage(ctx, params)
+		if err != nil {
+			return ProjectProgressReport{}, err
+		}
+		stats = append(stats, page...)
+
+		if next == "" {
+			break
+		}
+		params.Cursor = next
+	}
+
+	t.l.Debugf("%d resource language statistics of project '%s' were received", len(stats), project)
+	return NewProjectProgressReport(project, stats), nil
+}
+
+// The function aggregates the resource language statistics into a project progress report
+func NewProjectProgressReport(project string, stats []ResourseLanguageStat) ProjectProgressReport {
+	report := ProjectProgressReport{
+		Project:   project,
+		Languages: map[string]ProgressStats{},
+		Resources: map[string]ProgressStats{},
+		Details:   map[string]map[string]ProgressStats{},
+	}
+
+	for _, s := range stats {
+		resource := s.Relationships.Resource.Data.ID
+		language := s.Relationships.Language.Data.ID
+
+		report.Total.add(s)
+
+		l := report.Languages[language]
+		l.add(s)
+		report.Languages[language] = l
+
+		r := report.Resources[resource]
+		r.add(s)
+		report.Resources[resource] = r
+
+		if report.Details[resource] == nil {
+			report.Details[resource] = map[string]ProgressStats{}
+		}
+		d := report.Details[resource][language]
+		d.add(s)
+		report.Details[resource][language] = d
+	}
+
+	// Calculate the percentages
+	report.Total.updatePercents()
+	for k, l := range report.Languages {
+		l.updatePercents()
+		report.Languages[k] = l
+	}
+	for k, r := range report.Resources {
+		r.updatePercents()
+		report.Resources[k] = r
+	}
+	for _, langs := range report.Details {
+		for k, d := range langs {
+			d.updatePercents()
+			langs[k] = d
+		}
+	}
+
+	return report
+}
+
+// The function adds a resource language statistics to the aggregated statistics
+func (p *ProgressStats) add(s ResourseLanguageStat) {
+	a := s.Attributes
+	p.TotalStrings += a.TotalStrings
+	p.TranslatedStrings += a.TranslatedStrings
+	p.ReviewedStrings += a.ReviewedStrings
+	p.ProofreadStrings += a.ProofreadStrings
+	p.UntranslatedStrings += a.UntranslatedStrings
+	p.TotalWords += a.TotalWords
+	p.TranslatedWords += a.TranslatedWords
+	p.ReviewedWords += a.ReviewedWords
+	p.ProofreadWords += a.ProofreadWords
+	p.UntranslatedWords += a.UntranslatedWords
+
+	if a.LastUpdate.After(p.LastUpdate) {
+		p.LastUpdate = a.LastUpdate
+	}
+}
+
+// The function calculates the percentages of the aggregated statistics
+func (p *ProgressStats) updatePercents() {
+	p.TranslatedPercent = progressPercent(p.TranslatedStrings, p.TotalStrings)
+	p.ReviewedPercent = progressPercent(p.ReviewedStrings, p.TotalStrings)
+	p.ProofreadPercent = progressPercent(p.ProofreadStrings, p.TotalStrings)
+	p.TranslatedWordsPercent = progressPercent(p.TranslatedWords, p.TotalWords)
+	p.ReviewedWordsPercent = progressPercent(p.ReviewedWords, p.TotalWords)
+	p.ProofreadWordsPercent = progressPercent(p.ProofreadWords, p.TotalWords)
+}
+
+// The function returns the share of the part in the total (in percents, rounded down to 0.01)
+func progressPercent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part*10000/total) / 100
+}
+
+// The function prints the information about a project progress report
+func (t *TransifexApiClient) PrintProjectProgressReport(r ProjectProgressReport, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Project progress information:\n")
+		fmt.Printf("  Project: %v\n", r.Project)
+		fmt.Printf("  Total:\n")
+		printProgressStats(r.Total, "    ")
+		fmt.Printf("  Languages:\n")
+		for _, k := range sortedProgressKeys(r.Languages) {
+			fmt.Printf("    %v:\n", k)
+			printProgressStats(r.Languages[k], "      ")
+		}
+		fmt.Printf("  Resources:\n")
+		for _, k := range sortedProgressKeys(r.Resources) {
+			fmt.Printf("    %v:\n", k)
+			printProgressStats(r.Resources[k], "      ")
+		}
+
+	case "json":
+		text2print, err := json.Marshal(r)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function prints the aggregated statistics with the given indent
+func printProgressStats(p ProgressStats, indent string) {
+	fmt.Printf("%sStrings: %v translated, %v reviewed, %v proofread of %v\n",
+		indent, p.TranslatedStrings, p.ReviewedStrings, p.ProofreadStrings, p.TotalStrings)
+	fmt.Printf("%sWords: %v translated, %v reviewed, %v proofread of %v\n",
+		indent, p.TranslatedWords, p.ReviewedWords, p.ProofreadWords, p.TotalWords)
+	fmt.Printf("%sTranslatedPercent: %v\n", indent, p.TranslatedPercent)
+	fmt.Printf("%sReviewedPercent: %v\n", indent, p.ReviewedPercent)
+	fmt.Printf("%sProofreadPercent: %v\n", indent, p.ProofreadPercent)
+	fmt.Printf("%sTranslatedWordsPercent: %v\n", indent, p.TranslatedWordsPercent)
+	fmt.Printf("%sReviewedWordsPercent: %v\n", indent, p.ReviewedWordsPercent)
+	fmt.Printf("%sProofreadWordsPercent: %v\n", indent, p.ProofreadWordsPercent)
+	fmt.Printf("%sLastUpdate: %v\n", indent, p.LastUpdate)
+}
+
+// The function returns the sorted keys of the statistics map
+func sortedProgressKeys(m map[string]ProgressStats) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
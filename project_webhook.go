@@ -0,0 +1,565 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// The type of an event, that triggers a webhook
+type WebhookEventType string
+
+// The events supported by the project webhooks
+const (
+	WebhookTranslationCompleted        WebhookEventType = "translation_completed"
+	WebhookTranslationCompletedUpdated WebhookEventType = "translation_completed_updated"
+	WebhookReviewCompleted             WebhookEventType = "review_completed"
+	WebhookProofreadCompleted          WebhookEventType = "proofread_completed"
+	WebhookFillupCompleted             WebhookEventType = "fillup_completed"
+)
+
+// The function checks whether the event type is supported
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookTranslationCompleted,
+		WebhookTranslationCompletedUpdated,
+		WebhookReviewCompleted,
+		WebhookProofreadCompleted,
+		WebhookFillupCompleted:
+		return true
+	}
+	return false
+}
+
+type ProjectWebhook struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Active           bool             `json:"active"`
+		CallbackURL      string           `json:"callback_url"`
+		EventType        WebhookEventType `json:"event_type"`
+		SecretKey        string           `json:"secret_key"`
+		DatetimeCreated  time.Time        `json:"datetime_created"`
+		DatetimeModified time.Time        `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		Project struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"project"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ListProjectWebhooksParameters struct {
+	Organization string
+	Project      string
+	Cursor       string
+}
+
+type CreateProjectWebhookParameters struct {
+	Project     string
+	CallbackURL string
+	EventType   WebhookEventType
+	Secret      string
+	Active      string
+}
+
+type UpdateProjectWebhookParameters struct {
+	ProjectWebhook string
+	CallbackURL    string
+	EventType      WebhookEventType
+	Secret         string
+	Active         string
+}
+
+// Get the list of project webhooks of an organization.
+// https://developers.transifex.com/reference/get_project-webhooks
+func (t *TransifexApiClient) ListProjectWebhooks(params ListProjectWebhooksParameters) ([]ProjectWebhook, error) {
+
+	paramStr, err := t.createListProjectWebhooksParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var pws struct {
+		Data  []ProjectWebhook `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/project_webhooks",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&pws)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return pws.Data, nil
+}
+
+// Get the details of a project webhook.
+// https://developers.transifex.com/reference/get_project-webhooks-project-webhook-id
+func (t *TransifexApiClient) GetProjectWebhook(project_webhook_id string) (ProjectWebhook, error) {
+
+	// Define the variable to decode the service response
+	var pw struct {
+		Data ProjectWebhook `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/project_webhooks/",
+			project_webhook_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&pw)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	return pw.Data, nil
+}
+
+// Create a new project webhook.
+// The webhook is called by the service with a POST request every time the event occurs.
+// https://developers.transifex.com/reference/post_project-webhooks
+func (t *TransifexApiClient) CreateProjectWebhook(params CreateProjectWebhookParameters) (ProjectWebhook, error) {
+
+	body, err := t.createCreateProjectWebhookRequestBody(params)
+	if err != nil {
+		return ProjectWebhook{}, err
+	}
+
+	// Define the variable to decode the service response
+	var pw struct {
+		Data ProjectWebhook `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/project_webhooks",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&pw)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	return pw.Data, nil
+}
+
+// Update a project webhook.
+// Only the parameters with non-empty values are updated.
+// https://developers.transifex.com/reference/patch_project-webhooks-project-webhook-id
+func (t *TransifexApiClient) UpdateProjectWebhook(params UpdateProjectWebhookParameters) (ProjectWebhook, error) {
+
+	body, err := t.createUpdateProjectWebhookRequestBody(params)
+	if err != nil {
+		return ProjectWebhook{}, err
+	}
+
+	// Define the variable to decode the service response
+	var pw struct {
+		Data ProjectWebhook `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/project_webhooks/",
+			params.ProjectWebhook,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&pw)
+	if err != nil {
+		t.l.Error(err)
+		return ProjectWebhook{}, err
+	}
+
+	return pw.Data, nil
+}
+
+// Delete a project webhook.
+// https://developers.transifex.com/reference/delete_project-webhooks-project-webhook-id
+func (t *TransifexApiClient) DeleteProjectWebhook(project_webhook_id string) error {
+
+	// Check the mandatory parameter
+	if project_webhook_id == "" {
+		return fmt.Errorf("mandatory parameter 'project_webhook_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/project_webhooks/",
+			project_webhook_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// The function prints the information about a project webhook
+func (t *TransifexApiClient) PrintProjectWebhook(w ProjectWebhook, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Project webhook information:\n")
+		fmt.Printf("  ID: %v\n", w.ID)
+		fmt.Printf("  Type: %v\n", w.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Active: %v\n", w.Attributes.Active)
+		fmt.Printf("    CallbackURL: %v\n", w.Attributes.CallbackURL)
+		fmt.Printf("    EventType: %v\n", w.Attributes.EventType)
+		fmt.Printf("    DatetimeCreated: %v\n", w.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", w.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    Project:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", w.Relationships.Project.Data.Type)
+		fmt.Printf("        ID: %v\n", w.Relationships.Project.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", w.Relationships.Project.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", w.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(w)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListProjectWebhooksParametersString(params ListProjectWebhooksParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Organization option
+	if params.Organization == "" {
+		return "", fmt.Errorf("mandatory parameter 'Organization' is missed")
+	}
+	paramStr += "&filter[organization]=" + params.Organization
+
+	// Add optional Project value
+	if params.Project != "" {
+		paramStr += "&filter[project]=" + params.Project
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateProjectWebhookRequestBody(params CreateProjectWebhookParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Active      *bool            `json:"active,omitempty"`
+				CallbackURL string           `json:"callback_url"`
+				EventType   WebhookEventType `json:"event_type"`
+				SecretKey   string           `json:"secret_key,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				Project struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"project"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "project_webhooks"
+
+	// Add mandatory Project option
+	if params.Project == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	body.Data.Relationships.Project.Data.Type = "projects"
+	body.Data.Relationships.Project.Data.ID = params.Project
+
+	// Add mandatory CallbackURL option
+	if params.CallbackURL == "" {
+		return nil, fmt.Errorf("mandatory parameter 'CallbackURL' is missed")
+	}
+	if err := checkWebhookCallbackURL(params.CallbackURL); err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.CallbackURL = params.CallbackURL
+
+	// Add mandatory EventType option
+	if params.EventType == "" {
+		return nil, fmt.Errorf("mandatory parameter 'EventType' is missed")
+	}
+	if !params.EventType.IsValid() {
+		return nil, fmt.Errorf("unknown 'EventType' value")
+	}
+	body.Data.Attributes.EventType = params.EventType
+
+	// Add optional Secret value
+	body.Data.Attributes.SecretKey = params.Secret
+
+	// Add allowed Active value
+	active, err := parseOptionalBool(params.Active, "Active")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Active = active
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateProjectWebhookRequestBody(params UpdateProjectWebhookParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Active      *bool            `json:"active,omitempty"`
+				CallbackURL string           `json:"callback_url,omitempty"`
+				EventType   WebhookEventType `json:"event_type,omitempty"`
+				SecretKey   string           `json:"secret_key,omitempty"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "project_webhooks"
+
+	// Add mandatory ProjectWebhook option
+	if params.ProjectWebhook == "" {
+		return nil, fmt.Errorf("mandatory parameter 'ProjectWebhook' is missed")
+	}
+	body.Data.ID = params.ProjectWebhook
+
+	// Add optional CallbackURL value
+	if params.CallbackURL != "" {
+		if err := checkWebhookCallbackURL(params.CallbackURL); err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.CallbackURL = params.CallbackURL
+	}
+
+	// Add optional EventType value
+	if params.EventType != "" {
+		if !params.EventType.IsValid() {
+			return nil, fmt.Errorf("unknown 'EventType' value")
+		}
+		body.Data.Attributes.EventType = params.EventType
+	}
+
+	// Add optional Secret value
+	body.Data.Attributes.SecretKey = params.Secret
+
+	// Add allowed Active value
+	active, err := parseOptionalBool(params.Active, "Active")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Active = active
+
+	// At least one attribute should be updated
+	if params.CallbackURL == "" && params.EventType == "" && params.Secret == "" && active == nil {
+		return nil, fmt.Errorf("at least one of the parameters 'CallbackURL', 'EventType', 'Secret' or 'Active' should be set")
+	}
+
+	return json.Marshal(body)
+}
+
+// The function checks, that the webhook callback URL is an absolute HTTP(S) URL
+func checkWebhookCallbackURL(callbackURL string) error {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return fmt.Errorf("invalid 'CallbackURL' value: %s", err.Error())
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid 'CallbackURL' value: the scheme should be 'http' or 'https'")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid 'CallbackURL' value: the host is missed")
+	}
+	if u.User != nil {
+		return fmt.Errorf("invalid 'CallbackURL' value: the user credentials are not allowed")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package transifex_api_client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -13,23 +14,20 @@ import (
 
 type ResourceString struct {
 	Attributes struct {
-		AppearanceOrder          int    `json:"appearance_order"`
-		CharacterLimit           int    `json:"character_limit"`
-		Context                  string `json:"context"`
-		DatetimeCreated          string `json:"datetime_created"`
-		DeveloperComment         string `json:"developer_comment"`
-		Instructions             string `json:"instructions"`
-		Key                      string `json:"key"`
-		MetadataDatetimeModified string `json:"metadata_datetime_modified"`
-		Occurrences              string `json:"occurrences"`
-		Pluralized               bool   `json:"pluralized"`
-		StringHash               string `json:"string_hash"`
-		Strings                  struct {
-			One   string `json:"one"`
-			Other string `json:"other"`
-		} `json:"strings"`
-		StringsDatetimeModified string   `json:"strings_datetime_modified"`
-		Tags                    []string `json:"tags"`
+		AppearanceOrder          int           `json:"appearance_order"`
+		CharacterLimit           int           `json:"character_limit"`
+		Context                  string        `json:"context"`
+		DatetimeCreated          string        `json:"datetime_created"`
+		DeveloperComment         string        `json:"developer_comment"`
+		Instructions             string        `json:"instructions"`
+		Key                      string        `json:"key"`
+		MetadataDatetimeModified string        `json:"metadata_datetime_modified"`
+		Occurrences              string        `json:"occurrences"`
+		Pluralized               bool          `json:"pluralized"`
+		StringHash               string        `json:"string_hash"`
+		Strings                  PluralStrings `json:"strings"`
+		StringsDatetimeModified  string        `json:"strings_datetime_modified"`
+		Tags                     []string      `json:"tags"`
 	} `json:"attributes"`
 	ID    string `json:"id"`
 	Links struct {
@@ -85,15 +83,44 @@ type GetRevisionsOfResourceStringsParameters struct {
 	Limit    string
 }
 
-type ResourceStringRevision interface{}
+type ResourceStringRevision struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Strings         PluralStrings `json:"strings"`
+		DatetimeCreated time.Time     `json:"datetime_created"`
+	} `json:"attributes"`
+	Relationships struct {
+		ResourceString struct {
+			Data struct {
+				ID   string `json:"id"`
+				Type string `json:"type"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"resource_string"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
 
 // Get resource strings collection.
 // https://developers.transifex.com/reference/get_resource-strings
 func (t *TransifexApiClient) GetResourceStringsCollection(params GetResourceStringsCollectionParameters) ([]ResourceString, error) {
 
+	rsc, _, err := t.getResourceStringsCollectionPage(context.Background(), params)
+	return rsc, err
+}
+
+// The function requests a single page of resource strings
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) getResourceStringsCollectionPage(ctx context.Context, params GetResourceStringsCollectionParameters) ([]ResourceString, string, error) {
+
 	paramStr, err := t.createGetResourceStringsCollectionParametersString(params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Define the variable to decode the service response
@@ -107,7 +134,8 @@ func (t *TransifexApiClient) GetResourceStringsCollection(params GetResourceStri
 	}
 
 	// Create an API request
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		strings.Join([]string{
 			t.apiURL,
@@ -117,7 +145,7 @@ func (t *TransifexApiClient) GetResourceStringsCollection(params GetResourceStri
 		bytes.NewBuffer(nil))
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set authorization and Accept HTTP request headers
@@ -128,17 +156,25 @@ func (t *TransifexApiClient) GetResourceStringsCollection(params GetResourceStri
 	resp, err := t.client.Do(req)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, "", err
 	}
 
 	// Decode the JSON response into the corresponding variable
 	err = json.NewDecoder(resp.Body).Decode(&rsc)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
-	return rsc.Data, nil
+	return rsc.Data, cursorFromLink(rsc.Links.Next), nil
 }
 
 // Get the details of a specific resource string.
@@ -189,9 +225,17 @@ func (t *TransifexApiClient) GetResourceStringDetails(resource_string_id string)
 // https://developers.transifex.com/reference/get_resource-strings-revisions
 func (t *TransifexApiClient) GetRevisionsOfResourceStrings(params GetRevisionsOfResourceStringsParameters) ([]ResourceStringRevision, error) {
 
+	revisions, _, err := t.getRevisionsOfResourceStringsPage(params)
+	return revisions, err
+}
+
+// The function requests a single page of resource string revisions
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) getRevisionsOfResourceStringsPage(params GetRevisionsOfResourceStringsParameters) ([]ResourceStringRevision, string, error) {
+
 	paramStr, err := t.createGetRevisionsOfResourceStringsParametersString(params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Define the variable to decode the service response
@@ -215,7 +259,7 @@ func (t *TransifexApiClient) GetRevisionsOfResourceStrings(params GetRevisionsOf
 		bytes.NewBuffer(nil))
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set authorization and Accept HTTP request headers
@@ -226,17 +270,25 @@ func (t *TransifexApiClient) GetRevisionsOfResourceStrings(params GetRevisionsOf
 	resp, err := t.client.Do(req)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, "", err
 	}
 
 	// Decode the JSON response into the corresponding variable
 	err = json.NewDecoder(resp.Body).Decode(&rors)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
-	return rors.Data, nil
+	return rors.Data, cursorFromLink(rors.Links.Next), nil
 }
 
 // The function prints the information about a resource string
@@ -252,7 +304,7 @@ func (t *TransifexApiClient) PrintResourseString(s ResourceString, formatter str
 		fmt.Printf("    Key: %v\n", s.Attributes.Key)
 		fmt.Printf("    Context: %v\n", s.Attributes.Context)
 		fmt.Printf("    Strings:\n")
-		fmt.Printf("      Other: %v\n", s.Attributes.Strings.Other)
+		printPluralStrings(s.Attributes.Strings, "      ")
 		if len(s.Attributes.Tags) > 0 {
 			fmt.Printf("    Tags:\n")
 			for _, v := range s.Attributes.Tags {
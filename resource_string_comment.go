@@ -2,6 +2,7 @@ package transifex_api_client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -90,14 +91,38 @@ type ListResourceStringCommentsParameters struct {
 	Type           string
 }
 
+type CreateResourceStringCommentParameters struct {
+	ResourceString string
+	Language       string
+	Message        string
+	Type           string
+	Category       string
+	Priority       string
+}
+
+type UpdateResourceStringCommentParameters struct {
+	Comment  string
+	Status   string
+	Priority string
+	Category string
+}
+
 // Get resource strings collection.
 // Get a list of all resource string comments for an organization. You can further narrow down the list using the available filters.
 // https://developers.transifex.com/reference/get_resource-string-comments
 func (t *TransifexApiClient) ListResourceStringComments(params ListResourceStringCommentsParameters) ([]ResourceStringComment, error) {
 
+	comments, _, err := t.listResourceStringCommentsPage(context.Background(), params)
+	return comments, err
+}
+
+// The function requests a single page of resource string comments
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) listResourceStringCommentsPage(ctx context.Context, params ListResourceStringCommentsParameters) ([]ResourceStringComment, string, error) {
+
 	paramStr, err := t.createListResourceStringCommentsParametersString(params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Define the variable to decode the service response
@@ -111,7 +136,8 @@ func (t *TransifexApiClient) ListResourceStringComments(params ListResourceStrin
 	}
 
 	// Create an API request
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		strings.Join([]string{
 			t.apiURL,
@@ -121,7 +147,7 @@ func (t *TransifexApiClient) ListResourceStringComments(params ListResourceStrin
 		bytes.NewBuffer(nil))
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set authorization and Accept HTTP request headers
@@ -132,17 +158,17 @@ func (t *TransifexApiClient) ListResourceStringComments(params ListResourceStrin
 	resp, err := t.client.Do(req)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Decode the JSON response into the corresponding variable
 	err = json.NewDecoder(resp.Body).Decode(&rscomm)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
-	return rscomm.Data, nil
+	return rscomm.Data, cursorFromLink(rscomm.Links.Next), nil
 }
 
 // Get resource strings collection.
@@ -190,6 +216,216 @@ func (t *TransifexApiClient) GetResourceStringComment(comment_id string) (Resour
 	return rscomm.Data, nil
 }
 
+// Create a new comment or issue for a resource string.
+// The comment is created as a "comment" if the Type parameter is omitted.
+// https://developers.transifex.com/reference/post_resource-string-comments
+func (t *TransifexApiClient) CreateResourceStringComment(params CreateResourceStringCommentParameters) (ResourceStringComment, error) {
+
+	body, err := t.createCreateResourceStringCommentRequestBody(params)
+	if err != nil {
+		return ResourceStringComment{}, err
+	}
+
+	// Define the variable to decode the service response
+	var rscomm struct {
+		Data ResourceStringComment `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/resource_string_comments",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&rscomm)
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	return rscomm.Data, nil
+}
+
+// Reply to a resource string comment.
+// The API has no threads of comments, so the reply is created as a new comment for
+// the same resource string and language, which message starts with a quote of the
+// parent comment and its ID, e.g. "> [comment 123] Typo in the source\n\nFixed".
+// The service does not link the reply to the parent comment.
+func (t *TransifexApiClient) ReplyToResourceStringComment(comment_id, message string) (ResourceStringComment, error) {
+
+	if message == "" {
+		err := fmt.Errorf("mandatory parameter 'message' is missed")
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	// Get the comment to reply to
+	c, err := t.GetResourceStringComment(comment_id)
+	if err != nil {
+		return ResourceStringComment{}, err
+	}
+
+	return t.CreateResourceStringComment(CreateResourceStringCommentParameters{
+		ResourceString: c.Relationships.ResourceString.Data.ID,
+		Language:       c.Relationships.Language.Data.ID,
+		Message:        resourceStringCommentQuote(c) + message,
+		Type:           "comment",
+	})
+}
+
+// The function returns the quote of the first line of a comment, that starts a reply to it
+func resourceStringCommentQuote(c ResourceStringComment) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(c.Attributes.Message), "\n")
+	return fmt.Sprintf("> [comment %s] %s\n\n", c.ID, strings.TrimSpace(line))
+}
+
+// Update a resource string comment (e.g. resolve or reopen an issue).
+// https://developers.transifex.com/reference/patch_resource-string-comments-comment-id
+func (t *TransifexApiClient) UpdateResourceStringComment(params UpdateResourceStringCommentParameters) (ResourceStringComment, error) {
+
+	body, err := t.createUpdateResourceStringCommentRequestBody(params)
+	if err != nil {
+		return ResourceStringComment{}, err
+	}
+
+	// Define the variable to decode the service response
+	var rscomm struct {
+		Data ResourceStringComment `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/resource_string_comments/",
+			params.Comment,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&rscomm)
+	if err != nil {
+		t.l.Error(err)
+		return ResourceStringComment{}, err
+	}
+
+	return rscomm.Data, nil
+}
+
+// Resolve an issue.
+func (t *TransifexApiClient) ResolveResourceStringComment(comment_id string) (ResourceStringComment, error) {
+	return t.UpdateResourceStringComment(UpdateResourceStringCommentParameters{
+		Comment: comment_id,
+		Status:  "resolved",
+	})
+}
+
+// Reopen a resolved issue.
+func (t *TransifexApiClient) ReopenResourceStringComment(comment_id string) (ResourceStringComment, error) {
+	return t.UpdateResourceStringComment(UpdateResourceStringCommentParameters{
+		Comment: comment_id,
+		Status:  "open",
+	})
+}
+
+// Delete a resource string comment.
+// https://developers.transifex.com/reference/delete_resource-string-comments-comment-id
+func (t *TransifexApiClient) DeleteResourceStringComment(comment_id string) error {
+
+	// Check the mandatory parameter
+	if comment_id == "" {
+		return fmt.Errorf("mandatory parameter 'comment_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/resource_string_comments/",
+			comment_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
 // The function prints the information about a resource string comment
 func (t *TransifexApiClient) PrintResourceStringComment(c ResourceStringComment, formatter string) {
 
@@ -297,20 +533,12 @@ func (t *TransifexApiClient) createListResourceStringCommentsParametersString(pa
 	}
 
 	// Add allowed Priority option
-	switch strings.ToLower(params.Priority) {
-	case "low":
-		fallthrough
-	case "normal":
-		fallthrough
-	case "high":
-		fallthrough
-	case "critical":
-		fallthrough
-	case "blocker":
-		paramStr += "&filter[priority]=" + strings.ToLower(params.Priority)
-	case "":
-	default:
-		return "", fmt.Errorf("unknown 'Priority' value")
+	if params.Priority != "" {
+		priority, err := checkResourceStringCommentPriority(params.Priority)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[priority]=" + priority
 	}
 
 	// Add Resource option
@@ -324,31 +552,193 @@ func (t *TransifexApiClient) createListResourceStringCommentsParametersString(pa
 	}
 
 	// Add allowed Status option
-	switch strings.ToLower(params.Status) {
+	if params.Status != "" {
+		status, err := checkResourceStringCommentStatus(params.Status)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[status]=" + status
+	}
+
+	// Add allowed Type option
+	if params.Type != "" {
+		commentType, err := checkResourceStringCommentType(params.Type)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[type]=" + commentType
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// The function checks the comment priority value and returns it in the lower case
+func checkResourceStringCommentPriority(priority string) (string, error) {
+	switch strings.ToLower(priority) {
+	case "low":
+		fallthrough
+	case "normal":
+		fallthrough
+	case "high":
+		fallthrough
+	case "critical":
+		fallthrough
+	case "blocker":
+		return strings.ToLower(priority), nil
+	default:
+		return "", fmt.Errorf("unknown 'Priority' value")
+	}
+}
+
+// The function checks the comment status value and returns it in the lower case
+func checkResourceStringCommentStatus(status string) (string, error) {
+	switch strings.ToLower(status) {
 	case "open":
 		fallthrough
 	case "resolved":
-		paramStr += "&filter[status]=" + strings.ToLower(params.Status)
-	case "":
+		return strings.ToLower(status), nil
 	default:
 		return "", fmt.Errorf("unknown 'Status' value")
 	}
+}
 
-	// Add allowed Type option
-	switch strings.ToLower(params.Type) {
+// The function checks the comment type value and returns it in the lower case
+func checkResourceStringCommentType(commentType string) (string, error) {
+	switch strings.ToLower(commentType) {
 	case "issue":
 		fallthrough
 	case "comment":
-		paramStr += "&filter[type]=" + strings.ToLower(params.Type)
-	case "":
+		return strings.ToLower(commentType), nil
 	default:
 		return "", fmt.Errorf("unknown 'Type' value")
 	}
+}
 
-	// Replace the & with ? symbol if the string is not empty
-	if len(paramStr) > 0 {
-		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateResourceStringCommentRequestBody(params CreateResourceStringCommentParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Message  string `json:"message"`
+				Type     string `json:"type"`
+				Category string `json:"category,omitempty"`
+				Priority string `json:"priority,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				Language struct {
+					Data LanguageRelationship `json:"data"`
+				} `json:"language"`
+				ResourceString struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"resource_string"`
+			} `json:"relationships"`
+		} `json:"data"`
 	}
+	body.Data.Type = "resource_string_comments"
 
-	return paramStr, nil
+	// Add mandatory ResourceString option
+	if params.ResourceString == "" {
+		return nil, fmt.Errorf("mandatory parameter 'ResourceString' is missed")
+	}
+	body.Data.Relationships.ResourceString.Data.Type = "resource_strings"
+	body.Data.Relationships.ResourceString.Data.ID = params.ResourceString
+
+	// Add mandatory Language option
+	if params.Language == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Language' is missed")
+	}
+	body.Data.Relationships.Language.Data.Type = "languages"
+	body.Data.Relationships.Language.Data.ID = params.Language
+
+	// Add mandatory Message option
+	if params.Message == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Message' is missed")
+	}
+	body.Data.Attributes.Message = params.Message
+
+	// Add allowed Type option ("comment" by default)
+	body.Data.Attributes.Type = "comment"
+	if params.Type != "" {
+		commentType, err := checkResourceStringCommentType(params.Type)
+		if err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.Type = commentType
+	}
+
+	// Add optional Category option
+	body.Data.Attributes.Category = params.Category
+
+	// Add allowed Priority option (issues only)
+	if params.Priority != "" {
+		if body.Data.Attributes.Type != "issue" {
+			return nil, fmt.Errorf("parameter 'Priority' is allowed for issues only")
+		}
+		priority, err := checkResourceStringCommentPriority(params.Priority)
+		if err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.Priority = priority
+	}
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateResourceStringCommentRequestBody(params UpdateResourceStringCommentParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Status   string `json:"status,omitempty"`
+				Priority string `json:"priority,omitempty"`
+				Category string `json:"category,omitempty"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "resource_string_comments"
+
+	// Add mandatory Comment option
+	if params.Comment == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Comment' is missed")
+	}
+	body.Data.ID = params.Comment
+
+	// At least one attribute should be updated
+	if params.Status == "" && params.Priority == "" && params.Category == "" {
+		return nil, fmt.Errorf("at least one of the parameters 'Status', 'Priority' or 'Category' should be set")
+	}
+
+	// Add allowed Status option
+	if params.Status != "" {
+		status, err := checkResourceStringCommentStatus(params.Status)
+		if err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.Status = status
+	}
+
+	// Add allowed Priority option
+	if params.Priority != "" {
+		priority, err := checkResourceStringCommentPriority(params.Priority)
+		if err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.Priority = priority
+	}
+
+	// Add optional Category option
+	body.Data.Attributes.Category = params.Category
+
+	return json.Marshal(body)
 }
@@ -0,0 +1,261 @@
+package transifex_api_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The set of operations used in the diff of two source string versions
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// A single chunk of a diff between two versions of a source string
+type StringDiffOp struct {
+	Operation string `json:"operation"`
+	Text      string `json:"text"`
+}
+
+// A single version of a resource string in its history.
+// The Diff field contains the changes against the previous version,
+// keyed by the plural form name ("one", "other", ...).
+type ResourceStringHistoryEntry struct {
+	Strings         PluralStrings             `json:"strings"`
+	DatetimeCreated time.Time                 `json:"datetime_created"`
+	Current         bool                      `json:"current"`
+	Diff            map[string][]StringDiffOp `json:"diff"`
+}
+
+// Get the full ordered history of the source string with a given key.
+// The history consists of all the revisions of the string (the oldest first)
+// followed by the current version of the string. Every entry, except the first one,
+// contains the diff against the previous version.
+func (t *TransifexApiClient) GetResourceStringHistory(resource, key string) ([]ResourceStringHistoryEntry, error) {
+
+	// Check the mandatory parameters
+	if resource == "" {
+		return nil, fmt.Errorf("mandatory parameter 'resource' is missed")
+	}
+	if key == "" {
+		return nil, fmt.Errorf("mandatory parameter 'key' is missed")
+	}
+
+	// Collect the revisions of the string page by page
+	var revisions []ResourceStringRevision
+	params := GetRevisionsOfResourceStringsParameters{
+		Resource: resource,
+		Key:      key,
+	}
+	for {
+		page, next, err := t.getRevisionsOfResourceStringsPage(params)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, page...)
+
+		if next == "" {
+			break
+		}
+		params.Cursor = next
+	}
+
+	// Sort the revisions from the oldest to the newest one
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Attributes.DatetimeCreated.Before(revisions[j].Attributes.DatetimeCreated)
+	})
+
+	history := make([]ResourceStringHistoryEntry, 0, len(revisions)+1)
+	for _, r := range revisions {
+		var e ResourceStringHistoryEntry
+		e.Strings = r.Attributes.Strings
+		e.DatetimeCreated = r.Attributes.DatetimeCreated
+		history = append(history, e)
+	}
+
+	// Add the current version of the string
+	strs, err := t.GetResourceStringsCollection(GetResourceStringsCollectionParameters{
+		Resource: resource,
+		Key:      key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(strs) > 0 {
+		var e ResourceStringHistoryEntry
+		e.Strings = strs[0].Attributes.Strings
+		e.Current = true
+
+		// The strings modification date is the creation date of the current version
+		e.DatetimeCreated, err = time.Parse(time.RFC3339, strs[0].Attributes.StringsDatetimeModified)
+		if err != nil {
+			t.l.Debugf("unable to parse the strings modification date '%s': %s",
+				strs[0].Attributes.StringsDatetimeModified, err.Error())
+		}
+		history = append(history, e)
+	}
+
+	// Calculate the diffs between consecutive versions
+	for i := 1; i < len(history); i++ {
+		prev, cur := history[i-1].Strings, history[i].Strings
+		history[i].Diff = map[string][]StringDiffOp{}
+		for _, c := range PluralCategories {
+			if prev.Get(c) != "" || cur.Get(c) != "" {
+				history[i].Diff[c] = DiffStrings(prev.Get(c), cur.Get(c))
+			}
+		}
+	}
+
+	return history, nil
+}
+
+// The function calculates a word-level diff between two versions of a string.
+// Joining the texts of all the "equal" and "delete" chunks gives the old version,
+// joining the texts of all the "equal" and "insert" chunks gives the new one.
+func DiffStrings(oldStr, newStr string) []StringDiffOp {
+	a := tokenizeForDiff(oldStr)
+	b := tokenizeForDiff(newStr)
+
+	// Calculate the lengths of the longest common subsequences of the suffixes
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Walk through the table and merge the adjacent chunks of the same kind
+	var ops []StringDiffOp
+	add := func(op, text string) {
+		if n := len(ops); n > 0 && ops[n-1].Operation == op {
+			ops[n-1].Text += text
+			return
+		}
+		ops = append(ops, StringDiffOp{Operation: op, Text: text})
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			add(DiffEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			add(DiffDelete, a[i])
+			i++
+		default:
+			add(DiffInsert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		add(DiffDelete, a[i])
+	}
+	for ; j < len(b); j++ {
+		add(DiffInsert, b[j])
+	}
+
+	return ops
+}
+
+// The function splits a string into words, whitespace runs and single punctuation symbols
+func tokenizeForDiff(s string) []string {
+	var tokens []string
+	var cur []rune
+	kind := 0 // 0 - none, 1 - word, 2 - whitespace
+
+	flush := func() {
+		if len(cur) > 0 {
+			tokens = append(tokens, string(cur))
+			cur = cur[:0]
+		}
+	}
+
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r):
+			if kind != 1 {
+				flush()
+			}
+			kind = 1
+			cur = append(cur, r)
+		case unicode.IsSpace(r):
+			if kind != 2 {
+				flush()
+			}
+			kind = 2
+			cur = append(cur, r)
+		default:
+			flush()
+			kind = 0
+			tokens = append(tokens, string(r))
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// The function prints the history of a resource string
+func (t *TransifexApiClient) PrintResourceStringHistory(h []ResourceStringHistoryEntry, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		for _, e := range h {
+			fmt.Printf("  DatetimeCreated: %v\n", e.DatetimeCreated)
+			fmt.Printf("  Current: %v\n", e.Current)
+			fmt.Printf("  Strings:\n")
+			printPluralStrings(e.Strings, "    ")
+			if len(e.Diff) > 0 {
+				fmt.Printf("  Diff:\n")
+				for _, form := range PluralCategories {
+					if ops, ok := e.Diff[form]; ok {
+						fmt.Printf("    %v: %v\n", form, formatDiff(ops))
+					}
+				}
+			}
+		}
+
+	case "json":
+		text2print, err := json.Marshal(h)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function formats a diff as a single line: [-deleted-]{+inserted+}
+func formatDiff(ops []StringDiffOp) string {
+	var sb strings.Builder
+	for _, op := range ops {
+		switch op.Operation {
+		case DiffDelete:
+			sb.WriteString("[-" + op.Text + "-]")
+		case DiffInsert:
+			sb.WriteString("{+" + op.Text + "+}")
+		default:
+			sb.WriteString(op.Text)
+		}
+	}
+	return sb.String()
+}
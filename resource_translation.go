@@ -2,6 +2,7 @@ package transifex_api_client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -15,17 +16,15 @@ type ResourceTranslation struct {
 	ID         string `json:"id"`
 	Type       string `json:"type"`
 	Attributes struct {
-		Strings struct {
-			Other string `json:"other"`
-		} `json:"strings"`
-		Reviewed           bool      `json:"reviewed"`
-		Proofread          bool      `json:"proofread"`
-		Finalized          bool      `json:"finalized"`
-		Origin             string    `json:"origin"`
-		DatetimeCreated    time.Time `json:"datetime_created"`
-		DatetimeTranslated time.Time `json:"datetime_translated"`
-		DatetimeReviewed   time.Time `json:"datetime_reviewed"`
-		DatetimeProofread  time.Time `json:"datetime_proofread"`
+		Strings            PluralStrings `json:"strings"`
+		Reviewed           bool          `json:"reviewed"`
+		Proofread          bool          `json:"proofread"`
+		Finalized          bool          `json:"finalized"`
+		Origin             string        `json:"origin"`
+		DatetimeCreated    time.Time     `json:"datetime_created"`
+		DatetimeTranslated time.Time     `json:"datetime_translated"`
+		DatetimeReviewed   time.Time     `json:"datetime_reviewed"`
+		DatetimeProofread  time.Time     `json:"datetime_proofread"`
 	} `json:"attributes"`
 	Relationships struct {
 		Resource struct {
@@ -117,9 +116,17 @@ type GetResourceTranslationDetailsParameters struct {
 // https://developers.transifex.com/reference/get_resource-translations
 func (t *TransifexApiClient) GetResourceTranslationsCollection(params GetResourceTranslationsCollectionParameters) ([]ResourceTranslation, error) {
 
+	rtc, _, err := t.getResourceTranslationsCollectionPage(context.Background(), params)
+	return rtc, err
+}
+
+// The function requests a single page of resource translations
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) getResourceTranslationsCollectionPage(ctx context.Context, params GetResourceTranslationsCollectionParameters) ([]ResourceTranslation, string, error) {
+
 	paramStr, err := t.createGetResourceTranslationsCollectionParametersString(params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Define the variable to decode the service response
@@ -127,23 +134,20 @@ func (t *TransifexApiClient) GetResourceTranslationsCollection(params GetResourc
 		Data     []ResourceTranslation `json:"data"`
 		Included []struct {
 			Attributes struct {
-				AppearanceOrder          int    `json:"appearance_order"`
-				CharacterLimit           int    `json:"character_limit"`
-				Context                  string `json:"context"`
-				DatetimeCreated          string `json:"datetime_created"`
-				DeveloperComment         string `json:"developer_comment"`
-				Instructions             string `json:"instructions"`
-				Key                      string `json:"key"`
-				MetadataDatetimeModified string `json:"metadata_datetime_modified"`
-				Occurrences              string `json:"occurrences"`
-				Pluralized               bool   `json:"pluralized"`
-				StringHash               string `json:"string_hash"`
-				Strings                  struct {
-					One   string `json:"one"`
-					Other string `json:"other"`
-				} `json:"strings"`
-				StringsDatetimeModified string   `json:"strings_datetime_modified"`
-				Tags                    []string `json:"tags"`
+				AppearanceOrder          int           `json:"appearance_order"`
+				CharacterLimit           int           `json:"character_limit"`
+				Context                  string        `json:"context"`
+				DatetimeCreated          string        `json:"datetime_created"`
+				DeveloperComment         string        `json:"developer_comment"`
+				Instructions             string        `json:"instructions"`
+				Key                      string        `json:"key"`
+				MetadataDatetimeModified string        `json:"metadata_datetime_modified"`
+				Occurrences              string        `json:"occurrences"`
+				Pluralized               bool          `json:"pluralized"`
+				StringHash               string        `json:"string_hash"`
+				Strings                  PluralStrings `json:"strings"`
+				StringsDatetimeModified  string        `json:"strings_datetime_modified"`
+				Tags                     []string      `json:"tags"`
 			} `json:"attributes"`
 			ID    string `json:"id"`
 			Links struct {
@@ -188,7 +192,8 @@ func (t *TransifexApiClient) GetResourceTranslationsCollection(params GetResourc
 	}
 
 	// Create an API request
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		strings.Join([]string{
 			t.apiURL,
@@ -198,7 +203,7 @@ func (t *TransifexApiClient) GetResourceTranslationsCollection(params GetResourc
 		bytes.NewBuffer(nil))
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set authorization and Accept HTTP request headers
@@ -209,17 +214,25 @@ func (t *TransifexApiClient) GetResourceTranslationsCollection(params GetResourc
 	resp, err := t.client.Do(req)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, "", err
 	}
 
 	// Decode the JSON response into the corresponding variable
 	err = json.NewDecoder(resp.Body).Decode(&rtc)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
-	return rtc.Data, nil
+	return rtc.Data, cursorFromLink(rtc.Links.Next), nil
 }
 
 // Get a Resource Translation details.
@@ -282,7 +295,7 @@ func (t *TransifexApiClient) PrintResourceTranslation(r ResourceTranslation, for
 		fmt.Printf("  Type: %v\n", r.Type)
 		fmt.Printf("  Attributes:\n")
 		fmt.Printf("    Strings:\n")
-		fmt.Printf("      Other: %v\n", r.Attributes.Strings.Other)
+		printPluralStrings(r.Attributes.Strings, "      ")
 		fmt.Printf("    Reviewed: %v\n", r.Attributes.Reviewed)
 		fmt.Printf("    Proofread: %v\n", r.Attributes.Proofread)
 		fmt.Printf("    Finalized: %v\n", r.Attributes.Finalized)
@@ -2,6 +2,7 @@ package transifex_api_client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -66,9 +67,17 @@ type GetResourceLanguageStatsCollectionParameters struct {
 // https://developers.transifex.com/reference/get_resource-language-stats
 func (t *TransifexApiClient) GetResourceLanguageStatsCollection(params GetResourceLanguageStatsCollectionParameters) ([]ResourseLanguageStat, error) {
 
+	rlsc, _, err := t.getResourceLanguageStatsCollectionPage(context.Background(), params)
+	return rlsc, err
+}
+
+// The function requests a single page of resource language statistics
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) getResourceLanguageStatsCollectionPage(ctx context.Context, params GetResourceLanguageStatsCollectionParameters) ([]ResourseLanguageStat, string, error) {
+
 	paramStr, err := t.createGetResourceLanguageStatsCollectionParametersString(params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Define the variable to decode the service response
@@ -82,7 +91,8 @@ func (t *TransifexApiClient) GetResourceLanguageStatsCollection(params GetResour
 	}
 
 	// Create an API request
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
 		strings.Join([]string{
 			t.apiURL,
@@ -92,7 +102,7 @@ func (t *TransifexApiClient) GetResourceLanguageStatsCollection(params GetResour
 		bytes.NewBuffer(nil))
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
 	// Set authorization and Accept HTTP request headers
@@ -103,17 +113,25 @@ func (t *TransifexApiClient) GetResourceLanguageStatsCollection(params GetResour
 	resp, err := t.client.Do(req)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, "", err
 	}
 
 	// Decode the JSON response into the corresponding variable
 	err = json.NewDecoder(resp.Body).Decode(&rlsc)
 	if err != nil {
 		t.l.Error(err)
-		return nil, err
+		return nil, "", err
 	}
 
-	return rlsc.Data, nil
+	return rlsc.Data, cursorFromLink(rlsc.Links.Next), nil
 }
 
 // Get information for a specific supported language.
@@ -0,0 +1,660 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// A relationship to many objects of a task (resources, languages or assignees)
+type TaskRelationshipList struct {
+	Data []struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"data"`
+	Links struct {
+		Related string `json:"related"`
+	} `json:"links"`
+}
+
+type Task struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name             string    `json:"name"`
+		Description      string    `json:"description"`
+		Step             string    `json:"step"`
+		Status           string    `json:"status"`
+		DueDate          time.Time `json:"due_date"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		Project struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"project"`
+		Resources TaskRelationshipList `json:"resources"`
+		Languages TaskRelationshipList `json:"languages"`
+		Assignees TaskRelationshipList `json:"assignees"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ListTasksParameters struct {
+	Project  string
+	Step     string
+	Status   string
+	Language string
+	Resource string
+	Assignee string
+	Cursor   string
+}
+
+type CreateTaskParameters struct {
+	Project     string
+	Name        string
+	Description string
+	Step        string
+	Resources   []string
+	Languages   []string
+	Assignees   []string
+	DueDate     time.Time
+}
+
+type UpdateTaskParameters struct {
+	Task        string
+	Name        string
+	Description string
+	Status      string
+	Assignees   []string
+	DueDate     time.Time
+}
+
+// Get the list of tasks of a project.
+// https://developers.transifex.com/reference/get_tasks
+func (t *TransifexApiClient) ListTasks(params ListTasksParameters) ([]Task, error) {
+
+	paramStr, err := t.createListTasksParametersString(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the variable to decode the service response
+	var ts struct {
+		Data  []Task `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/tasks",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&ts)
+	if err != nil {
+		t.l.Error(err)
+		return nil, err
+	}
+
+	return ts.Data, nil
+}
+
+// Get the details of a task.
+// https://developers.transifex.com/reference/get_tasks-task-id
+func (t *TransifexApiClient) GetTask(task_id string) (Task, error) {
+
+	// Define the variable to decode the service response
+	var tk struct {
+		Data Task `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/tasks/",
+			task_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&tk)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	return tk.Data, nil
+}
+
+// Create a new task, that assigns the translation, review or proofreading
+// of the resources into the languages to the users.
+// https://developers.transifex.com/reference/post_tasks
+func (t *TransifexApiClient) CreateTask(params CreateTaskParameters) (Task, error) {
+
+	body, err := t.createCreateTaskRequestBody(params)
+	if err != nil {
+		return Task{}, err
+	}
+
+	// Define the variable to decode the service response
+	var tk struct {
+		Data Task `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/tasks",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&tk)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	return tk.Data, nil
+}
+
+// Update a task.
+// Only the parameters with non-empty values are updated.
+// https://developers.transifex.com/reference/patch_tasks-task-id
+func (t *TransifexApiClient) UpdateTask(params UpdateTaskParameters) (Task, error) {
+
+	body, err := t.createUpdateTaskRequestBody(params)
+	if err != nil {
+		return Task{}, err
+	}
+
+	// Define the variable to decode the service response
+	var tk struct {
+		Data Task `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/tasks/",
+			params.Task,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&tk)
+	if err != nil {
+		t.l.Error(err)
+		return Task{}, err
+	}
+
+	return tk.Data, nil
+}
+
+// Change the status of a task ("open", "in_progress", "completed" or "cancelled")
+// https://developers.transifex.com/reference/patch_tasks-task-id
+func (t *TransifexApiClient) UpdateTaskStatus(task_id, status string) (Task, error) {
+	return t.UpdateTask(UpdateTaskParameters{
+		Task:   task_id,
+		Status: status,
+	})
+}
+
+// Delete a task.
+// https://developers.transifex.com/reference/delete_tasks-task-id
+func (t *TransifexApiClient) DeleteTask(task_id string) error {
+
+	// Check the mandatory parameter
+	if task_id == "" {
+		return fmt.Errorf("mandatory parameter 'task_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/tasks/",
+			task_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// The function prints the information about a task
+func (t *TransifexApiClient) PrintTask(tk Task, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Task information:\n")
+		fmt.Printf("  ID: %v\n", tk.ID)
+		fmt.Printf("  Type: %v\n", tk.Type)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Name: %v\n", tk.Attributes.Name)
+		fmt.Printf("    Description: %v\n", tk.Attributes.Description)
+		fmt.Printf("    Step: %v\n", tk.Attributes.Step)
+		fmt.Printf("    Status: %v\n", tk.Attributes.Status)
+		fmt.Printf("    DueDate: %v\n", tk.Attributes.DueDate)
+		fmt.Printf("    DatetimeCreated: %v\n", tk.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", tk.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    Project:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", tk.Relationships.Project.Data.Type)
+		fmt.Printf("        ID: %v\n", tk.Relationships.Project.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", tk.Relationships.Project.Links.Related)
+		for _, r := range []struct {
+			name string
+			list TaskRelationshipList
+		}{
+			{"Resources", tk.Relationships.Resources},
+			{"Languages", tk.Relationships.Languages},
+			{"Assignees", tk.Relationships.Assignees},
+		} {
+			fmt.Printf("    %v:\n", r.name)
+			fmt.Printf("      Data:\n")
+			for _, d := range r.list.Data {
+				fmt.Printf("        - Type: %v\n", d.Type)
+				fmt.Printf("          ID: %v\n", d.ID)
+			}
+			fmt.Printf("      Links:\n")
+			fmt.Printf("        Related: %v\n", r.list.Links.Related)
+		}
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", tk.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(tk)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListTasksParametersString(params ListTasksParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Project option
+	if params.Project == "" {
+		return "", fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	paramStr += "&filter[project]=" + params.Project
+
+	// Add allowed Step value
+	if params.Step != "" {
+		step, err := checkTaskStep(params.Step)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[step]=" + step
+	}
+
+	// Add allowed Status value
+	if params.Status != "" {
+		status, err := checkTaskStatus(params.Status)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[status]=" + status
+	}
+
+	// Add optional Language value
+	if params.Language != "" {
+		paramStr += "&filter[language]=" + params.Language
+	}
+
+	// Add optional Resource value
+	if params.Resource != "" {
+		paramStr += "&filter[resource]=" + params.Resource
+	}
+
+	// Add optional Assignee value
+	if params.Assignee != "" {
+		paramStr += "&filter[assignee]=" + params.Assignee
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
+
+// A relationship to many objects in a request body
+type taskRelationshipData struct {
+	Data []struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"data"`
+}
+
+// The function converts a list of IDs into a relationship to many objects of the given type
+func newTaskRelationshipData(objType string, ids []string) *taskRelationshipData {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	r := &taskRelationshipData{}
+	for _, id := range ids {
+		r.Data = append(r.Data, struct {
+			Type string `json:"type"`
+			ID   string `json:"id"`
+		}{objType, id})
+	}
+	return r
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateTaskRequestBody(params CreateTaskParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Name        string `json:"name"`
+				Description string `json:"description,omitempty"`
+				Step        string `json:"step"`
+				DueDate     string `json:"due_date,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				Project struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"project"`
+				Resources *taskRelationshipData `json:"resources"`
+				Languages *taskRelationshipData `json:"languages"`
+				Assignees *taskRelationshipData `json:"assignees,omitempty"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "tasks"
+
+	// Add mandatory Project option
+	if params.Project == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Project' is missed")
+	}
+	body.Data.Relationships.Project.Data.Type = "projects"
+	body.Data.Relationships.Project.Data.ID = params.Project
+
+	// Add mandatory Name option
+	if params.Name == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Name' is missed")
+	}
+	body.Data.Attributes.Name = params.Name
+
+	// Add optional Description value
+	body.Data.Attributes.Description = params.Description
+
+	// Add mandatory Step option
+	if params.Step == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Step' is missed")
+	}
+	step, err := checkTaskStep(params.Step)
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Step = step
+
+	// Add mandatory Resources option
+	if len(params.Resources) == 0 {
+		return nil, fmt.Errorf("mandatory parameter 'Resources' is missed")
+	}
+	body.Data.Relationships.Resources = newTaskRelationshipData("resources", params.Resources)
+
+	// Add mandatory Languages option
+	if len(params.Languages) == 0 {
+		return nil, fmt.Errorf("mandatory parameter 'Languages' is missed")
+	}
+	body.Data.Relationships.Languages = newTaskRelationshipData("languages", params.Languages)
+
+	// Add optional Assignees value
+	body.Data.Relationships.Assignees = newTaskRelationshipData("users", params.Assignees)
+
+	// Add optional DueDate value
+	if !params.DueDate.IsZero() {
+		body.Data.Attributes.DueDate = params.DueDate.UTC().Format(time.RFC3339)
+	}
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateTaskRequestBody(params UpdateTaskParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Name        string `json:"name,omitempty"`
+				Description string `json:"description,omitempty"`
+				Status      string `json:"status,omitempty"`
+				DueDate     string `json:"due_date,omitempty"`
+			} `json:"attributes"`
+			Relationships *struct {
+				Assignees *taskRelationshipData `json:"assignees"`
+			} `json:"relationships,omitempty"`
+		} `json:"data"`
+	}
+	body.Data.Type = "tasks"
+
+	// Add mandatory Task option
+	if params.Task == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Task' is missed")
+	}
+	body.Data.ID = params.Task
+
+	// Add optional Name value
+	body.Data.Attributes.Name = params.Name
+
+	// Add optional Description value
+	body.Data.Attributes.Description = params.Description
+
+	// Add allowed Status value
+	if params.Status != "" {
+		status, err := checkTaskStatus(params.Status)
+		if err != nil {
+			return nil, err
+		}
+		body.Data.Attributes.Status = status
+	}
+
+	// Add optional DueDate value
+	if !params.DueDate.IsZero() {
+		body.Data.Attributes.DueDate = params.DueDate.UTC().Format(time.RFC3339)
+	}
+
+	// Add optional Assignees value (replaces the current assignees)
+	if len(params.Assignees) > 0 {
+		body.Data.Relationships = &struct {
+			Assignees *taskRelationshipData `json:"assignees"`
+		}{newTaskRelationshipData("users", params.Assignees)}
+	}
+
+	// At least one attribute should be updated
+	if params.Name == "" && params.Description == "" && params.Status == "" && params.DueDate.IsZero() && len(params.Assignees) == 0 {
+		return nil, fmt.Errorf("at least one of the parameters 'Name', 'Description', 'Status', 'DueDate' or 'Assignees' should be set")
+	}
+
+	return json.Marshal(body)
+}
+
+// The function checks the step of a task and returns it in the lower case
+func checkTaskStep(step string) (string, error) {
+	switch strings.ToLower(step) {
+	case "translation", "review", "proofread":
+		return strings.ToLower(step), nil
+	}
+	return "", fmt.Errorf("unknown 'Step' value")
+}
+
+// The function checks the status of a task and returns it in the lower case
+func checkTaskStatus(status string) (string, error) {
+	switch strings.ToLower(status) {
+	case "open", "in_progress", "completed", "cancelled":
+		return strings.ToLower(status), nil
+	}
+	return "", fmt.Errorf("unknown 'Status' value")
+}
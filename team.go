@@ -48,8 +48,6 @@ type TeamRelationship struct {
 	ID   string `json:"id"`
 }
 
-type TeamManager interface{}
-
 type TeamManagerRelationship struct {
 	Type       string `json:"type"`
 	ID         string `json:"id"`
@@ -100,6 +98,22 @@ type GetTeamManagerRelationshipsParameters struct {
 	Cursor string
 }
 
+type CreateTeamParameters struct {
+	Organization string
+	Name         string
+	AutoJoin     string
+	ClaRequired  string
+	Cla          string
+}
+
+type UpdateTeamParameters struct {
+	Team        string
+	Name        string
+	AutoJoin    string
+	ClaRequired string
+	Cla         string
+}
+
 // Get the list of teams that belong to a single organization.
 // https://developers.transifex.com/reference/get_teams
 func (t *TransifexApiClient) ListTeams(params ListTeamsParameters) ([]Team, error) {
@@ -198,9 +212,9 @@ func (t *TransifexApiClient) GetTeamDetail(team_id string) (Team, error) {
 	return td.Data, nil
 }
 
-// Get the managers of a team.
+// Get the managers of a team (the users, that can be printed with PrintUser).
 // https://developers.transifex.com/reference/get_teams-team-id-managers
-func (t *TransifexApiClient) GetTeamManagers(params GetTeamManagersParameters) ([]TeamManager, error) {
+func (t *TransifexApiClient) GetTeamManagers(params GetTeamManagersParameters) ([]User, error) {
 
 	paramStr, err := t.createGetTeamManagersParametersString(params)
 	if err != nil {
@@ -209,7 +223,7 @@ func (t *TransifexApiClient) GetTeamManagers(params GetTeamManagersParameters) (
 
 	// Define the variable to decode the service response
 	var tms struct {
-		Data  []TeamManager `json:"data"`
+		Data  []User `json:"data"`
 		Links struct {
 			Self     string `json:"self"`
 			Next     string `json:"next"`
@@ -309,6 +323,250 @@ func (t *TransifexApiClient) GetTeamManagerRelationships(params GetTeamManagerRe
 	return tmrs.Data, nil
 }
 
+// Create a new team in an organization.
+// https://developers.transifex.com/reference/post_teams
+func (t *TransifexApiClient) CreateTeam(params CreateTeamParameters) (Team, error) {
+
+	body, err := t.createCreateTeamRequestBody(params)
+	if err != nil {
+		return Team{}, err
+	}
+
+	// Define the variable to decode the service response
+	var td struct {
+		Data Team `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/teams",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	return td.Data, nil
+}
+
+// Update the attributes of a team.
+// Only the parameters with non-empty values are updated.
+// https://developers.transifex.com/reference/patch_teams-team-id
+func (t *TransifexApiClient) UpdateTeam(params UpdateTeamParameters) (Team, error) {
+
+	body, err := t.createUpdateTeamRequestBody(params)
+	if err != nil {
+		return Team{}, err
+	}
+
+	// Define the variable to decode the service response
+	var td struct {
+		Data Team `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/teams/",
+			params.Team,
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&td)
+	if err != nil {
+		t.l.Error(err)
+		return Team{}, err
+	}
+
+	return td.Data, nil
+}
+
+// Delete a team.
+// https://developers.transifex.com/reference/delete_teams-team-id
+func (t *TransifexApiClient) DeleteTeam(team_id string) error {
+
+	// Check the mandatory parameter
+	if team_id == "" {
+		return fmt.Errorf("mandatory parameter 'team_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/teams/",
+			team_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Add managers to a team.
+// https://developers.transifex.com/reference/post_teams-team-id-relationships-managers
+func (t *TransifexApiClient) AddTeamManagers(team_id string, users []string) error {
+	return t.changeTeamManagers("POST", team_id, users)
+}
+
+// Remove managers from a team.
+// https://developers.transifex.com/reference/delete_teams-team-id-relationships-managers
+func (t *TransifexApiClient) RemoveTeamManagers(team_id string, users []string) error {
+	return t.changeTeamManagers("DELETE", team_id, users)
+}
+
+// Replace all the managers of a team with the given users.
+// https://developers.transifex.com/reference/patch_teams-team-id-relationships-managers
+func (t *TransifexApiClient) ReplaceTeamManagers(team_id string, users []string) error {
+	return t.changeTeamManagers("PATCH", team_id, users)
+}
+
+// The function sends a request to the team managers relationships endpoint
+// with the given HTTP method and the list of users
+func (t *TransifexApiClient) changeTeamManagers(method, team_id string, users []string) error {
+
+	// Check the mandatory parameters
+	if team_id == "" {
+		return fmt.Errorf("mandatory parameter 'team_id' is missed")
+	}
+	if len(users) == 0 && method != "PATCH" {
+		return fmt.Errorf("mandatory parameter 'users' is missed")
+	}
+
+	// Create the request body
+	var body struct {
+		Data []TeamRelationship `json:"data"`
+	}
+	body.Data = make([]TeamRelationship, 0, len(users))
+	for _, u := range users {
+		body.Data = append(body.Data, TeamRelationship{Type: "users", ID: u})
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		method,
+		strings.Join([]string{
+			t.apiURL,
+			"/teams/",
+			team_id,
+			"/relationships/managers",
+		}, ""),
+		bytes.NewBuffer(b))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
 // The function prints the information about an organization
 func (t *TransifexApiClient) PrintTeam(tt Team, formatter string) {
 
@@ -438,3 +696,109 @@ func (t *TransifexApiClient) createGetTeamManagerRelationshipsParametersString(p
 
 	return paramStr, nil
 }
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateTeamRequestBody(params CreateTeamParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Name        string `json:"name"`
+				AutoJoin    *bool  `json:"auto_join,omitempty"`
+				ClaRequired *bool  `json:"cla_required,omitempty"`
+				Cla         string `json:"cla,omitempty"`
+			} `json:"attributes"`
+			Relationships struct {
+				Organization struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"organization"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "teams"
+
+	// Add mandatory Organization option
+	if params.Organization == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Organization' is missed")
+	}
+	body.Data.Relationships.Organization.Data.Type = "organizations"
+	body.Data.Relationships.Organization.Data.ID = params.Organization
+
+	// Add mandatory Name option
+	if params.Name == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Name' is missed")
+	}
+	body.Data.Attributes.Name = params.Name
+
+	// Add allowed AutoJoin value
+	autoJoin, err := parseOptionalBool(params.AutoJoin, "AutoJoin")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.AutoJoin = autoJoin
+
+	// Add allowed ClaRequired value
+	claRequired, err := parseOptionalBool(params.ClaRequired, "ClaRequired")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.ClaRequired = claRequired
+
+	// Add optional Cla value
+	body.Data.Attributes.Cla = params.Cla
+
+	return json.Marshal(body)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createUpdateTeamRequestBody(params UpdateTeamParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Name        string `json:"name,omitempty"`
+				AutoJoin    *bool  `json:"auto_join,omitempty"`
+				ClaRequired *bool  `json:"cla_required,omitempty"`
+				Cla         string `json:"cla,omitempty"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "teams"
+
+	// Add mandatory Team option
+	if params.Team == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Team' is missed")
+	}
+	body.Data.ID = params.Team
+
+	// Add optional Name value
+	body.Data.Attributes.Name = params.Name
+
+	// Add allowed AutoJoin value
+	autoJoin, err := parseOptionalBool(params.AutoJoin, "AutoJoin")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.AutoJoin = autoJoin
+
+	// Add allowed ClaRequired value
+	claRequired, err := parseOptionalBool(params.ClaRequired, "ClaRequired")
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.ClaRequired = claRequired
+
+	// Add optional Cla value
+	body.Data.Attributes.Cla = params.Cla
+
+	// At least one attribute should be updated
+	if params.Name == "" && autoJoin == nil && claRequired == nil && params.Cla == "" {
+		return nil, fmt.Errorf("at least one of the parameters 'Name', 'AutoJoin', 'ClaRequired' or 'Cla' should be set")
+	}
+
+	return json.Marshal(body)
+}
@@ -0,0 +1,361 @@
+package transifex_api_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type TeamJoinRequest struct {
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	Attributes struct {
+		Status           string    `json:"status"`
+		Message          string    `json:"message"`
+		DatetimeCreated  time.Time `json:"datetime_created"`
+		DatetimeModified time.Time `json:"datetime_modified"`
+	} `json:"attributes"`
+	Relationships struct {
+		User struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"user"`
+		Team struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"team"`
+		Language struct {
+			Data struct {
+				Type string `json:"type"`
+				ID   string `json:"id"`
+			} `json:"data"`
+			Links struct {
+				Related string `json:"related"`
+			} `json:"links"`
+		} `json:"language"`
+	} `json:"relationships"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+type ListTeamJoinRequestsParameters struct {
+	Organization string
+	Team         string
+	Language     string
+	Status       string
+	Cursor       string
+}
+
+// List the requests of users to join teams.
+// https://developers.transifex.com/reference/get_team-join-requests
+func (t *TransifexApiClient) ListTeamJoinRequests(params ListTeamJoinRequestsParameters) ([]TeamJoinRequest, error) {
+
+	jrs, _, err := t.listTeamJoinRequestsPage(params)
+	return jrs, err
+}
+
+// The function requests a single page of team join requests
+// and returns it together with the cursor of the next page (if any)
+func (t *TransifexApiClient) listTeamJoinRequestsPage(params ListTeamJoinRequestsParameters) ([]TeamJoinRequest, string, error) {
+
+	paramStr, err := t.createListTeamJoinRequestsParametersString(params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Define the variable to decode the service response
+	var jrs struct {
+		Data  []TeamJoinRequest `json:"data"`
+		Links struct {
+			Self     string `json:"self"`
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"GET",
+		strings.Join([]string{
+			t.apiURL,
+			"/team_join_requests",
+			paramStr,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return nil, "", err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return nil, "", err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&jrs)
+	if err != nil {
+		t.l.Error(err)
+		return nil, "", err
+	}
+
+	return jrs.Data, cursorFromLink(jrs.Links.Next), nil
+}
+
+// Approve a request of a user to join a team.
+// https://developers.transifex.com/reference/patch_team-join-requests-team-join-request-id
+func (t *TransifexApiClient) ApproveTeamJoinRequest(team_join_request_id string) (TeamJoinRequest, error) {
+	return t.setTeamJoinRequestStatus(team_join_request_id, "approved")
+}
+
+// Decline a request of a user to join a team.
+// https://developers.transifex.com/reference/patch_team-join-requests-team-join-request-id
+func (t *TransifexApiClient) DeclineTeamJoinRequest(team_join_request_id string) (TeamJoinRequest, error) {
+	return t.setTeamJoinRequestStatus(team_join_request_id, "declined")
+}
+
+// Go through all the pending join requests matching the parameters and approve
+// the ones, for which the approve function returns true (all of them, if the function is nil).
+// The rest of the requests are left intact.
+// The function returns the approved requests together with the joined errors (if any).
+func (t *TransifexApiClient) ApproveTeamJoinRequests(params ListTeamJoinRequestsParameters, approve func(TeamJoinRequest) bool) ([]TeamJoinRequest, error) {
+
+	// Only the pending requests can be approved
+	params.Status = "pending"
+
+	// Collect the join requests page by page
+	var pending []TeamJoinRequest
+	for {
+		page, next, err := t.listTeamJoinRequestsPage(params)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, page...)
+
+		if next == "" {
+			break
+		}
+		params.Cursor = next
+	}
+
+	var approved []TeamJoinRequest
+	var errs []error
+	for _, jr := range pending {
+		if approve != nil && !approve(jr) {
+			continue
+		}
+
+		res, err := t.ApproveTeamJoinRequest(jr.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("team join request '%s': %w", jr.ID, err))
+			continue
+		}
+		approved = append(approved, res)
+	}
+
+	return approved, errors.Join(errs...)
+}
+
+// The function changes the status of a team join request
+func (t *TransifexApiClient) setTeamJoinRequestStatus(team_join_request_id, status string) (TeamJoinRequest, error) {
+
+	// Check the mandatory parameter
+	if team_join_request_id == "" {
+		return TeamJoinRequest{}, fmt.Errorf("mandatory parameter 'team_join_request_id' is missed")
+	}
+
+	// Create the request body
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Status string `json:"status"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "team_join_requests"
+	body.Data.ID = team_join_request_id
+	body.Data.Attributes.Status = status
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.l.Error(err)
+		return TeamJoinRequest{}, err
+	}
+
+	// Define the variable to decode the service response
+	var jr struct {
+		Data TeamJoinRequest `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/team_join_requests/",
+			team_join_request_id,
+		}, ""),
+		bytes.NewBuffer(b))
+	if err != nil {
+		t.l.Error(err)
+		return TeamJoinRequest{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return TeamJoinRequest{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return TeamJoinRequest{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&jr)
+	if err != nil {
+		t.l.Error(err)
+		return TeamJoinRequest{}, err
+	}
+
+	return jr.Data, nil
+}
+
+// The function prints the information about a team join request
+func (t *TransifexApiClient) PrintTeamJoinRequest(jr TeamJoinRequest, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Team join request information:\n")
+		fmt.Printf("  Type: %v\n", jr.Type)
+		fmt.Printf("  ID: %v\n", jr.ID)
+		fmt.Printf("  Attributes:\n")
+		fmt.Printf("    Status: %v\n", jr.Attributes.Status)
+		fmt.Printf("    Message: %v\n", jr.Attributes.Message)
+		fmt.Printf("    DatetimeCreated: %v\n", jr.Attributes.DatetimeCreated)
+		fmt.Printf("    DatetimeModified: %v\n", jr.Attributes.DatetimeModified)
+		fmt.Printf("  Relationships:\n")
+		fmt.Printf("    User:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", jr.Relationships.User.Data.Type)
+		fmt.Printf("        ID: %v\n", jr.Relationships.User.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", jr.Relationships.User.Links.Related)
+		fmt.Printf("    Team:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", jr.Relationships.Team.Data.Type)
+		fmt.Printf("        ID: %v\n", jr.Relationships.Team.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", jr.Relationships.Team.Links.Related)
+		fmt.Printf("    Language:\n")
+		fmt.Printf("      Data:\n")
+		fmt.Printf("        Type: %v\n", jr.Relationships.Language.Data.Type)
+		fmt.Printf("        ID: %v\n", jr.Relationships.Language.Data.ID)
+		fmt.Printf("      Links:\n")
+		fmt.Printf("        Related: %v\n", jr.Relationships.Language.Links.Related)
+		fmt.Printf("  Links:\n")
+		fmt.Printf("    Self: %v\n", jr.Links.Self)
+
+	case "json":
+		text2print, err := json.Marshal(jr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function checks the input set of parameters and converts it into a valid URL parameters string
+func (t *TransifexApiClient) createListTeamJoinRequestsParametersString(params ListTeamJoinRequestsParameters) (string, error) {
+	// Initialize the parameters string
+	paramStr := ""
+
+	// Add mandatory Organization option
+	if params.Organization == "" {
+		return "", fmt.Errorf("mandatory parameter 'Organization' is missed")
+	}
+	paramStr += "&filter[organization]=" + params.Organization
+
+	// Add optional Team value
+	if params.Team != "" {
+		paramStr += "&filter[team]=" + params.Team
+	}
+
+	// Add optional Language value
+	if params.Language != "" {
+		paramStr += "&filter[language]=" + params.Language
+	}
+
+	// Add allowed Status value
+	switch strings.ToLower(params.Status) {
+	case "pending":
+		fallthrough
+	case "approved":
+		fallthrough
+	case "declined":
+		paramStr += "&filter[status]=" + strings.ToLower(params.Status)
+	case "":
+	default:
+		return "", fmt.Errorf("unknown 'Status' value")
+	}
+
+	// Add optional Cursor value (from the previous response!)
+	// The cursor used for pagination.
+	// The value of the cursor must be retrieved from pagination links included in previous responses;
+	// you should not attempt to write them on your own.
+	if params.Cursor != "" {
+		paramStr += "&page[cursor]=" + params.Cursor
+	}
+
+	// Replace the & with ? symbol if the string is not empty
+	if len(paramStr) > 0 {
+		paramStr = "?" + strings.TrimPrefix(paramStr, "&")
+	}
+
+	return paramStr, nil
+}
@@ -3,6 +3,7 @@ package transifex_api_client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -24,6 +25,13 @@ type GetSingleTeamMembershipParameters struct {
 	Include        string
 }
 
+type CreateTeamMembershipParameters struct {
+	User     string
+	Team     string
+	Language string
+	Role     string
+}
+
 type TeamMembership struct {
 	Type       string `json:"type"`
 	ID         string `json:"id"`
@@ -178,6 +186,247 @@ func (t *TransifexApiClient) GetSingleTeamMembership(params GetSingleTeamMembers
 	return tms.Data, nil
 }
 
+// Create a new team membership (add a user to a team for a language with a role).
+// https://developers.transifex.com/reference/post_team-memberships
+func (t *TransifexApiClient) CreateTeamMembership(params CreateTeamMembershipParameters) (TeamMembership, error) {
+
+	body, err := t.createCreateTeamMembershipRequestBody(params)
+	if err != nil {
+		return TeamMembership{}, err
+	}
+
+	// Define the variable to decode the service response
+	var tms struct {
+		Data TeamMembership `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/team_memberships",
+		}, ""),
+		bytes.NewBuffer(body))
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusCreated {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&tms)
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	return tms.Data, nil
+}
+
+// Create a set of team memberships at once.
+// All the parameters are checked before sending the first request.
+// If some of the memberships can not be created, the function continues with the rest of them
+// and returns the successfully created memberships together with the joined errors.
+func (t *TransifexApiClient) CreateTeamMemberships(params []CreateTeamMembershipParameters) ([]TeamMembership, error) {
+
+	// Check all the parameters first
+	for i, p := range params {
+		if _, err := t.createCreateTeamMembershipRequestBody(p); err != nil {
+			return nil, fmt.Errorf("team membership #%d: %s", i, err.Error())
+		}
+	}
+
+	var created []TeamMembership
+	var errs []error
+	for _, p := range params {
+		tm, err := t.CreateTeamMembership(p)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user '%s': %w", p.User, err))
+			continue
+		}
+		created = append(created, tm)
+	}
+
+	return created, errors.Join(errs...)
+}
+
+// Add a set of users to a team for a language with the same role.
+func (t *TransifexApiClient) AddUsersToTeam(team, language, role string, users []string) ([]TeamMembership, error) {
+	params := make([]CreateTeamMembershipParameters, 0, len(users))
+	for _, u := range users {
+		params = append(params, CreateTeamMembershipParameters{
+			User:     u,
+			Team:     team,
+			Language: language,
+			Role:     role,
+		})
+	}
+	return t.CreateTeamMemberships(params)
+}
+
+// Update the role of a team membership.
+// https://developers.transifex.com/reference/patch_team-memberships-team-membership-id
+func (t *TransifexApiClient) UpdateTeamMembershipRole(team_membership_id, role string) (TeamMembership, error) {
+
+	// Check the mandatory parameters
+	if team_membership_id == "" {
+		return TeamMembership{}, fmt.Errorf("mandatory parameter 'team_membership_id' is missed")
+	}
+	if role == "" {
+		return TeamMembership{}, fmt.Errorf("mandatory parameter 'role' is missed")
+	}
+	role, err := checkTeamMembershipRole(role)
+	if err != nil {
+		return TeamMembership{}, err
+	}
+
+	// Create the request body
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			ID         string `json:"id"`
+			Attributes struct {
+				Role string `json:"role"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	body.Data.Type = "team_memberships"
+	body.Data.ID = team_membership_id
+	body.Data.Attributes.Role = role
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	// Define the variable to decode the service response
+	var tms struct {
+		Data TeamMembership `json:"data"`
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"PATCH",
+		strings.Join([]string{
+			t.apiURL,
+			"/team_memberships/",
+			team_membership_id,
+		}, ""),
+		bytes.NewBuffer(b))
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusOK {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	// Decode the JSON response into the corresponding variable
+	err = json.NewDecoder(resp.Body).Decode(&tms)
+	if err != nil {
+		t.l.Error(err)
+		return TeamMembership{}, err
+	}
+
+	return tms.Data, nil
+}
+
+// Delete a team membership.
+// https://developers.transifex.com/reference/delete_team-memberships-team-membership-id
+func (t *TransifexApiClient) DeleteTeamMembership(team_membership_id string) error {
+
+	// Check the mandatory parameter
+	if team_membership_id == "" {
+		return fmt.Errorf("mandatory parameter 'team_membership_id' is missed")
+	}
+
+	// Create an API request
+	req, err := http.NewRequest(
+		"DELETE",
+		strings.Join([]string{
+			t.apiURL,
+			"/team_memberships/",
+			team_membership_id,
+		}, ""),
+		bytes.NewBuffer(nil))
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	// Set authorization and Accept HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+
+	// Perform the request
+	resp, err := t.client.Do(req)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Check the response status
+	if resp.StatusCode != http.StatusNoContent {
+		err = newApiError(resp)
+		t.l.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Delete a set of team memberships at once.
+// If some of the memberships can not be deleted, the function continues with the rest of them
+// and returns the joined errors.
+func (t *TransifexApiClient) DeleteTeamMemberships(team_membership_ids []string) error {
+	var errs []error
+	for _, id := range team_membership_ids {
+		if err := t.DeleteTeamMembership(id); err != nil {
+			errs = append(errs, fmt.Errorf("team membership '%s': %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // The function prints the information about an team membership
 func (t *TransifexApiClient) PrintTeamMembership(tm TeamMembership, formatter string) {
 
@@ -248,16 +497,12 @@ func (t *TransifexApiClient) createListTeamMembershipsParametersString(params Li
 	}
 
 	// Add optional Role value
-	switch strings.ToLower(params.Role) {
-	case "coordinator":
-		fallthrough
-	case "translator":
-		fallthrough
-	case "reviewer":
-		paramStr += "&filter[role]=" + strings.ToLower(params.Role)
-	case "":
-	default:
-		return "", fmt.Errorf("unknown 'Origin' value")
+	if params.Role != "" {
+		role, err := checkTeamMembershipRole(params.Role)
+		if err != nil {
+			return "", err
+		}
+		paramStr += "&filter[role]=" + role
 	}
 
 	// Add optional Cursor value (from the previous response!)
@@ -309,3 +554,77 @@ func (t *TransifexApiClient) createGetSingleTeamMembershipParametersString(param
 
 	return paramStr, nil
 }
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createCreateTeamMembershipRequestBody(params CreateTeamMembershipParameters) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type       string `json:"type"`
+			Attributes struct {
+				Role string `json:"role"`
+			} `json:"attributes"`
+			Relationships struct {
+				Language struct {
+					Data LanguageRelationship `json:"data"`
+				} `json:"language"`
+				Team struct {
+					Data TeamRelationship `json:"data"`
+				} `json:"team"`
+				User struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"user"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "team_memberships"
+
+	// Add mandatory User option
+	if params.User == "" {
+		return nil, fmt.Errorf("mandatory parameter 'User' is missed")
+	}
+	body.Data.Relationships.User.Data.Type = "users"
+	body.Data.Relationships.User.Data.ID = params.User
+
+	// Add mandatory Team option
+	if params.Team == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Team' is missed")
+	}
+	body.Data.Relationships.Team.Data.Type = "teams"
+	body.Data.Relationships.Team.Data.ID = params.Team
+
+	// Add mandatory Language option
+	if params.Language == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Language' is missed")
+	}
+	body.Data.Relationships.Language.Data.Type = "languages"
+	body.Data.Relationships.Language.Data.ID = params.Language
+
+	// Add mandatory Role option
+	if params.Role == "" {
+		return nil, fmt.Errorf("mandatory parameter 'Role' is missed")
+	}
+	role, err := checkTeamMembershipRole(params.Role)
+	if err != nil {
+		return nil, err
+	}
+	body.Data.Attributes.Role = role
+
+	return json.Marshal(body)
+}
+
+// The function checks the team membership role value and returns it in the lower case
+func checkTeamMembershipRole(role string) (string, error) {
+	switch strings.ToLower(role) {
+	case "coordinator":
+		fallthrough
+	case "translator":
+		fallthrough
+	case "reviewer":
+		return strings.ToLower(role), nil
+	default:
+		return "", fmt.Errorf("unknown 'Role' value")
+	}
+}
@@ -0,0 +1,547 @@
+package transifex_api_client
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The date format of the TMX files
+const tmxDateFormat = "20060102T150405Z"
+
+// The TMXHeader struct stores the header attributes of a TMX file
+type TMXHeader struct {
+	CreationTool        string
+	CreationToolVersion string
+	SourceLanguage      string // the code of the source language, e.g. "en" or "*all*"
+	AdminLanguage       string
+	DataType            string
+	CreationDate        time.Time
+}
+
+// The TMXVariant struct stores the text of a translation unit in a language
+type TMXVariant struct {
+	Language string
+	Text     string
+}
+
+// The TMXUnit struct stores a translation unit: the same text in several languages
+type TMXUnit struct {
+	ID           string
+	Variants     []TMXVariant
+	Properties   map[string]string // the "prop" elements by their types
+	Notes        []string
+	CreationDate time.Time
+	ChangeDate   time.Time
+}
+
+// The options of the TMX units creation from the resource translations
+type TMXUnitsOptions struct {
+	SourceLanguage string // the code of the source language ("en", if not set)
+	TargetLanguage string // the code of the target language
+	ReviewedOnly   bool   // use the reviewed translations only
+}
+
+// The TMXEncoder writes the translation units into a TMX file one by one
+type TMXEncoder struct {
+	w      *bufio.Writer
+	enc    *xml.Encoder
+	header TMXHeader
+	opened bool
+	closed bool
+}
+
+// The TMXDecoder reads the translation units of a TMX file one by one
+type TMXDecoder struct {
+	dec       *xml.Decoder
+	header    TMXHeader
+	hasHeader bool
+}
+
+// The XML model of a translation unit
+type tmxTU struct {
+	XMLName      xml.Name `xml:"tu"`
+	TUID         string   `xml:"tuid,attr,omitempty"`
+	CreationDate string   `xml:"creationdate,attr,omitempty"`
+	ChangeDate   string   `xml:"changedate,attr,omitempty"`
+	Props        []struct {
+		Type string `xml:"type,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"prop"`
+	Notes []string `xml:"note"`
+	TUVs  []tmxTUV `xml:"tuv"`
+}
+
+type tmxTUV struct {
+	Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr,omitempty"`
+	OldLang string `xml:"lang,attr,omitempty"` // TMX 1.1
+	Seg     struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"seg"`
+}
+
+// The function returns a new encoder, that writes a TMX 1.4 file with the header
+func NewTMXEncoder(w io.Writer, header TMXHeader) *TMXEncoder {
+	bw := bufio.NewWriter(w)
+	enc := xml.NewEncoder(bw)
+	enc.Indent("", "  ")
+	return &TMXEncoder{w: bw, enc: enc, header: header}
+}
+
+// The function writes a translation unit
+func (e *TMXEncoder) Encode(u TMXUnit) error {
+	if e.closed {
+		return fmt.Errorf("the TMX encoder is closed")
+	}
+	if err := e.open(); err != nil {
+		return err
+	}
+
+	tu := tmxTU{TUID: u.ID, Notes: u.Notes}
+	if !u.CreationDate.IsZero() {
+		tu.CreationDate = u.CreationDate.UTC().Format(tmxDateFormat)
+	}
+	if !u.ChangeDate.IsZero() {
+		tu.ChangeDate = u.ChangeDate.UTC().Format(tmxDateFormat)
+	}
+
+	// Write the properties in a stable order
+	types := make([]string, 0, len(u.Properties))
+	for t := range u.Properties {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		tu.Props = append(tu.Props, struct {
+			Type string `xml:"type,attr"`
+			Text string `xml:",chardata"`
+		}{t, u.Properties[t]})
+	}
+
+	for _, v := range u.Variants {
+		tuv := tmxTUV{Lang: v.Language}
+		tuv.Seg.Inner = xliffEscape(v.Text)
+		tu.TUVs = append(tu.TUVs, tuv)
+	}
+
+	return e.enc.Encode(tu)
+}
+
+// The function completes the TMX file. The underlying writer is not closed.
+func (e *TMXEncoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	if err := e.open(); err != nil {
+		return err
+	}
+	e.closed = true
+
+	for _, name := range []string{"body", "tmx"} {
+		if err := e.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	if err := e.enc.Flush(); err != nil {
+		return err
+	}
+	e.w.WriteString("\n")
+	return e.w.Flush()
+}
+
+// The function writes the beginning of the file (before the first unit)
+func (e *TMXEncoder) open() error {
+	if e.opened {
+		return nil
+	}
+	e.opened = true
+
+	h := e.header
+	if h.CreationTool == "" {
+		h.CreationTool = "transifex_api_client"
+	}
+	if h.CreationToolVersion == "" {
+		h.CreationToolVersion = "1.0"
+	}
+	if h.SourceLanguage == "" {
+		h.SourceLanguage = "en"
+	}
+	if h.AdminLanguage == "" {
+		h.AdminLanguage = "en"
+	}
+	if h.DataType == "" {
+		h.DataType = "plaintext"
+	}
+	if h.CreationDate.IsZero() {
+		h.CreationDate = time.Now()
+	}
+
+	attr := func(name, value string) xml.Attr {
+		return xml.Attr{Name: xml.Name{Local: name}, Value: value}
+	}
+
+	e.w.WriteString(xml.Header)
+	tokens := []xml.Token{
+		xml.StartElement{Name: xml.Name{Local: "tmx"}, Attr: []xml.Attr{attr("version", "1.4")}},
+		xml.StartElement{Name: xml.Name{Local: "header"}, Attr: []xml.Attr{
+			attr("creationtool", h.CreationTool),
+			attr("creationtoolversion", h.CreationToolVersion),
+			attr("segtype", "sentence"),
+			attr("o-tmf", "transifex"),
+			attr("adminlang", h.AdminLanguage),
+			attr("srclang", h.SourceLanguage),
+			attr("datatype", h.DataType),
+			attr("creationdate", h.CreationDate.UTC().Format(tmxDateFormat)),
+		}},
+		xml.EndElement{Name: xml.Name{Local: "header"}},
+		xml.StartElement{Name: xml.Name{Local: "body"}},
+	}
+	for _, t := range tokens {
+		if err := e.enc.EncodeToken(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The function returns a new decoder of a TMX file
+func NewTMXDecoder(r io.Reader) *TMXDecoder {
+	return &TMXDecoder{dec: xml.NewDecoder(r)}
+}
+
+// The function returns the header of the TMX file.
+// The header is read before the first unit, if it was not read yet.
+func (d *TMXDecoder) Header() (TMXHeader, error) {
+	for !d.hasHeader {
+		tok, err := d.dec.Token()
+		if err == io.EOF {
+			return TMXHeader{}, fmt.Errorf("the TMX file has no header")
+		}
+		if err != nil {
+			return TMXHeader{}, err
+		}
+		if st, ok := tok.(xml.StartElement); ok {
+			if err := d.startElement(st); err != nil {
+				return TMXHeader{}, err
+			}
+			if st.Name.Local == "body" && !d.hasHeader {
+				return TMXHeader{}, fmt.Errorf("the TMX file has no header")
+			}
+		}
+	}
+	return d.header, nil
+}
+
+// The function returns the next translation unit. At the end of the file it returns io.EOF.
+func (d *TMXDecoder) Next() (TMXUnit, error) {
+	for {
+		tok, err := d.dec.Token()
+		if err != nil {
+			return TMXUnit{}, err
+		}
+
+		st, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if st.Name.Local != "tu" {
+			if err := d.startElement(st); err != nil {
+				return TMXUnit{}, err
+			}
+			continue
+		}
+
+		var tu tmxTU
+		if err := d.dec.DecodeElement(&tu, &st); err != nil {
+			return TMXUnit{}, fmt.Errorf("unable to decode the translation unit: %s", err.Error())
+		}
+		return newTMXUnit(tu), nil
+	}
+}
+
+// The function processes the elements of the TMX file other than the units
+func (d *TMXDecoder) startElement(st xml.StartElement) error {
+	if st.Name.Local != "header" {
+		return nil
+	}
+
+	for _, a := range st.Attr {
+		switch a.Name.Local {
+		case "creationtool":
+			d.header.CreationTool = a.Value
+		case "creationtoolversion":
+			d.header.CreationToolVersion = a.Value
+		case "srclang":
+			d.header.SourceLanguage = a.Value
+		case "adminlang":
+			d.header.AdminLanguage = a.Value
+		case "datatype":
+			d.header.DataType = a.Value
+		case "creationdate":
+			d.header.CreationDate, _ = time.Parse(tmxDateFormat, a.Value)
+		}
+	}
+	d.hasHeader = true
+
+	// Skip the content of the header (notes, properties, user-defined encodings)
+	return d.dec.Skip()
+}
+
+// The function reads all the translation units of a TMX file
+func ReadTMX(r io.Reader) (TMXHeader, []TMXUnit, error) {
+	d := NewTMXDecoder(r)
+	header, err := d.Header()
+	if err != nil {
+		return TMXHeader{}, nil, err
+	}
+
+	var units []TMXUnit
+	for {
+		u, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return TMXHeader{}, nil, err
+		}
+		units = append(units, u)
+	}
+	return header, units, nil
+}
+
+// The function writes the translation units into a TMX file
+func WriteTMX(w io.Writer, header TMXHeader, units []TMXUnit) error {
+	e := NewTMXEncoder(w, header)
+	for _, u := range units {
+		if err := e.Encode(u); err != nil {
+			return err
+		}
+	}
+	return e.Close()
+}
+
+// The function returns the text of the unit in the language (the language subtags are ignored,
+// if the unit has no variant with the exact language code)
+func (u TMXUnit) Text(language string) (string, bool) {
+	var candidate *TMXVariant
+	for i, v := range u.Variants {
+		if strings.EqualFold(v.Language, language) {
+			return v.Text, true
+		}
+		if candidate == nil && tmxLanguageMatches(v.Language, language) {
+			candidate = &u.Variants[i]
+		}
+	}
+	if candidate != nil {
+		return candidate.Text, true
+	}
+	return "", false
+}
+
+// The function returns the unit with the variants of the source and the target languages only.
+// If the unit has no variant in one of the languages, the function returns false.
+func (u TMXUnit) LanguagePair(source, target string) (TMXUnit, bool) {
+	src, ok := u.Text(source)
+	if !ok || src == "" {
+		return TMXUnit{}, false
+	}
+	tgt, ok := u.Text(target)
+	if !ok || tgt == "" {
+		return TMXUnit{}, false
+	}
+
+	pair := u
+	pair.Variants = []TMXVariant{{source, src}, {target, tgt}}
+	return pair, true
+}
+
+// The function returns the units, that have the variants of the source and the target languages,
+// with the variants of these languages only
+func FilterTMXUnits(units []TMXUnit, source, target string) []TMXUnit {
+	var filtered []TMXUnit
+	for _, u := range units {
+		if pair, ok := u.LanguagePair(source, target); ok {
+			filtered = append(filtered, pair)
+		}
+	}
+	return filtered
+}
+
+// The function merges several sets of translation units and removes the duplicates
+// (the units with the same texts in the same languages). Of the duplicates the most
+// recently changed unit is kept, its properties and notes are completed by the other ones.
+// The input units are not modified.
+func MergeTMXUnits(sets ...[]TMXUnit) []TMXUnit {
+	var merged []TMXUnit
+	index := map[string]int{}
+
+	for _, set := range sets {
+		for _, u := range set {
+			// Copy the properties and the notes, so that the merge does not change the caller's units
+			u.Properties = cloneStringMap(u.Properties)
+			u.Notes = append([]string(nil), u.Notes...)
+
+			key := u.dedupeKey()
+			i, ok := index[key]
+			if !ok {
+				index[key] = len(merged)
+				merged = append(merged, u)
+				continue
+			}
+
+			kept, other := merged[i], u
+			if other.ChangeDate.After(kept.ChangeDate) {
+				kept, other = other, kept
+			}
+			for t, v := range other.Properties {
+				if _, ok := kept.Properties[t]; !ok {
+					if kept.Properties == nil {
+						kept.Properties = map[string]string{}
+					}
+					kept.Properties[t] = v
+				}
+			}
+			for _, n := range other.Notes {
+				if !containsString(kept.Notes, n) {
+					kept.Notes = append(kept.Notes, n)
+				}
+			}
+			merged[i] = kept
+		}
+	}
+
+	return merged
+}
+
+// The function returns a copy of the map (nil for a nil map)
+func cloneStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// The function creates the translation units from the resource strings and their translations.
+// The plural forms are converted into separate units with the "x-plural-category" property.
+func NewTMXUnits(strs []ResourceString, trs []ResourceTranslation, opts TMXUnitsOptions) []TMXUnit {
+	source := opts.SourceLanguage
+	if source == "" {
+		source = "en"
+	}
+
+	// Index the resource strings by their IDs
+	byID := map[string]ResourceString{}
+	for _, s := range strs {
+		byID[s.ID] = s
+	}
+
+	var units []TMXUnit
+	for _, tr := range trs {
+		s, ok := byID[tr.Relationships.ResourceString.Data.ID]
+		if !ok || (opts.ReviewedOnly && !tr.Attributes.Reviewed) {
+			continue
+		}
+
+		changed := tr.Attributes.DatetimeTranslated
+		if tr.Attributes.DatetimeReviewed.After(changed) {
+			changed = tr.Attributes.DatetimeReviewed
+		}
+
+		categories := []string{"other"}
+		if s.Attributes.Pluralized {
+			categories = tr.Attributes.Strings.Categories()
+		}
+		for _, c := range categories {
+			target := tr.Attributes.Strings.Get(c)
+			src := s.Attributes.Strings.Get(c)
+			if src == "" {
+				src = s.Attributes.Strings.Other
+			}
+			if target == "" || src == "" {
+				continue
+			}
+
+			u := TMXUnit{
+				Variants:     []TMXVariant{{source, src}, {opts.TargetLanguage, target}},
+				Properties:   map[string]string{"x-key": s.Attributes.Key},
+				CreationDate: tr.Attributes.DatetimeCreated,
+				ChangeDate:   changed,
+			}
+			if s.Attributes.Context != "" {
+				u.Properties["x-context"] = s.Attributes.Context
+			}
+			if s.Attributes.Pluralized {
+				u.Properties["x-plural-category"] = c
+			}
+			units = append(units, u)
+		}
+	}
+
+	return units
+}
+
+// The function returns the key of the unit to find the duplicates
+func (u TMXUnit) dedupeKey() string {
+	pairs := make([]string, 0, len(u.Variants))
+	for _, v := range u.Variants {
+		pairs = append(pairs, strings.ToLower(v.Language)+"\x00"+v.Text)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "\x01")
+}
+
+// The function converts the XML model of a translation unit
+func newTMXUnit(tu tmxTU) TMXUnit {
+	u := TMXUnit{ID: tu.TUID, Notes: tu.Notes}
+	u.CreationDate, _ = time.Parse(tmxDateFormat, tu.CreationDate)
+	u.ChangeDate, _ = time.Parse(tmxDateFormat, tu.ChangeDate)
+
+	for _, p := range tu.Props {
+		if u.Properties == nil {
+			u.Properties = map[string]string{}
+		}
+		u.Properties[p.Type] = p.Text
+	}
+
+	for _, tuv := range tu.TUVs {
+		lang := tuv.Lang
+		if lang == "" {
+			lang = tuv.OldLang
+		}
+		// The TMX segments are used as the translation memory only, so the empty inline codes are dropped
+		text, _ := xliffPlainText(tuv.Seg.Inner)
+		u.Variants = append(u.Variants, TMXVariant{lang, text})
+	}
+	return u
+}
+
+// The function checks whether the language codes match, ignoring the region subtags
+// (e.g. "uk" matches "uk-UA" and "uk_UA")
+func tmxLanguageMatches(a, b string) bool {
+	base := func(s string) string {
+		s = strings.ToLower(s)
+		if i := strings.IndexAny(s, "-_"); i >= 0 {
+			s = s[:i]
+		}
+		return s
+	}
+	return base(a) == base(b)
+}
+
+// The function checks whether the slice contains the string
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
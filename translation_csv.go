@@ -0,0 +1,316 @@
+package transifex_api_client
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The fixed columns of the translations CSV file.
+// Each language has the columns "<code>", "<code> reviewed", "<code> proofread" and "<code> modified".
+var translationsCSVColumns = []string{"key", "context", "character_limit", "source", "source_modified"}
+
+// The first characters of the cells, that the spreadsheets evaluate as formulas
+const translationsCSVFormulaChars = "=+-@\t\r"
+
+// The plural variable used to present the pluralized strings as ICU messages in the CSV cells
+const translationsCSVPluralVariable = "count"
+
+// The CSVRowIssue struct describes a row of a translations CSV file, that cannot be imported
+type CSVRowIssue struct {
+	Line           int    `json:"line"`
+	Key            string `json:"key"`
+	Language       string `json:"language,omitempty"`        // the language code of the cell (if any)
+	ResourceString string `json:"resource_string,omitempty"` // the ID of the matched resource string (if any)
+	Reason         string `json:"reason"`
+}
+
+// The CSVImportReport struct stores the result of matching a translations CSV file against the resource strings
+type CSVImportReport struct {
+	Updates       []TranslationUpdate
+	Unmatched     []CSVRowIssue // the rows without a matching resource string
+	SourceChanged []CSVRowIssue // the rows, which source text has changed since the export
+	Conflicts     []CSVRowIssue // the cells, that cannot be imported safely
+	Untranslated  int           // the number of skipped empty translation cells
+	Unchanged     int           // the number of translation cells equal to the current translations
+}
+
+// The function writes the resource strings and their translations to the languages
+// as a CSV file with one row per key. The pluralized strings are presented as ICU
+// plural messages, e.g. "{count, plural, one {# file} other {# files}}".
+// The cells starting with "=", "+", "-" or "@" are prefixed with "'", so that
+// the spreadsheets do not evaluate them as formulas.
+func WriteTranslationsCSV(w io.Writer, strs []ResourceString, trs []ResourceTranslation, langs []Language) error {
+	cw := csv.NewWriter(w)
+
+	header := append([]string{}, translationsCSVColumns...)
+	for _, l := range langs {
+		c := l.Attributes.Code
+		header = append(header, c, c+" reviewed", c+" proofread", c+" modified")
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	// Index the translations by the language and the resource string
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.Language.Data.ID+"/"+tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	for _, s := range sortResourceStrings(strs) {
+		row := []string{
+			s.Attributes.Key,
+			s.Attributes.Context,
+			"",
+			translationsCSVText(s.Attributes.Strings, s.Attributes.Pluralized),
+			s.Attributes.StringsDatetimeModified,
+		}
+		if s.Attributes.CharacterLimit > 0 {
+			row[2] = strconv.Itoa(s.Attributes.CharacterLimit)
+		}
+
+		for _, l := range langs {
+			tr, ok := translations[l.ID+"/"+s.ID]
+			if !ok || len(tr.Attributes.Strings.Categories()) == 0 {
+				row = append(row, "", "", "", "")
+				continue
+			}
+			row = append(row,
+				translationsCSVText(tr.Attributes.Strings, s.Attributes.Pluralized),
+				translationsCSVFlag(tr.Attributes.Reviewed),
+				translationsCSVFlag(tr.Attributes.Proofread),
+				translationModified(tr).Format(time.RFC3339),
+			)
+		}
+
+		for i := range row {
+			row[i] = escapeTranslationsCSVCell(row[i])
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// The function reads an edited translations CSV file and matches its rows against
+// the resource strings (by the keys) and the current translations.
+// The byte order mark of the spreadsheets and the formula prefixes of the export are removed.
+// The rows, which source text or modification time differ from the resource strings,
+// are reported as changed since the export and not imported. The translations modified
+// on the service after the export are reported as conflicts.
+func ReadTranslationsCSV(r io.Reader, strs []ResourceString, trs []ResourceTranslation, langs []Language) (CSVImportReport, error) {
+	var report CSVImportReport
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return report, err
+	}
+	if len(records) == 0 {
+		return report, fmt.Errorf("the CSV file is empty")
+	}
+
+	// Find the columns
+	header := records[0]
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"key", "source"} {
+		if _, ok := columns[name]; !ok {
+			return report, fmt.Errorf("mandatory column '%s' is missed", name)
+		}
+	}
+
+	// Find the languages of the file. A column is a language, if it is one of the languages
+	// or has the companion "<code> reviewed" and "<code> modified" columns; the other
+	// columns (e.g. the translator's notes) are skipped.
+	byCode := map[string]Language{}
+	for _, l := range langs {
+		byCode[l.Attributes.Code] = l
+	}
+	var fileLangs []Language
+	for _, name := range header {
+		name = strings.TrimSpace(name)
+		if containsString(translationsCSVColumns, name) || strings.Contains(name, " ") {
+			continue
+		}
+		l, ok := byCode[name]
+		if !ok {
+			_, reviewed := columns[name+" reviewed"]
+			_, modified := columns[name+" modified"]
+			if reviewed && modified {
+				return report, fmt.Errorf("unknown language '%s' of the CSV column", name)
+			}
+			continue
+		}
+		fileLangs = append(fileLangs, l)
+	}
+
+	// Index the resource strings and the translations
+	byKey := map[string]*ResourceString{}
+	for i := range strs {
+		byKey[strs[i].Attributes.Key] = &strs[i]
+	}
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.Language.Data.ID+"/"+tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	matched := map[string]bool{}
+	for n, record := range records[1:] {
+		line := n + 2
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return unescapeTranslationsCSVCell(record[i])
+			}
+			return ""
+		}
+
+		key := cell("key")
+		s := byKey[key]
+		if s == nil {
+			report.Unmatched = append(report.Unmatched, CSVRowIssue{Line: line, Key: key, Reason: "no resource string with the key"})
+			continue
+		}
+		if matched[s.ID] {
+			report.Conflicts = append(report.Conflicts, CSVRowIssue{Line: line, Key: key, ResourceString: s.ID, Reason: "the resource string is matched by several rows"})
+			continue
+		}
+		matched[s.ID] = true
+
+		// Check whether the source has changed since the export
+		changed := cell("source") != translationsCSVText(s.Attributes.Strings, s.Attributes.Pluralized)
+		if _, ok := columns["source_modified"]; ok && cell("source_modified") != s.Attributes.StringsDatetimeModified {
+			changed = true
+		}
+		if changed {
+			report.SourceChanged = append(report.SourceChanged, CSVRowIssue{Line: line, Key: key, ResourceString: s.ID, Reason: "the source text has changed since the export"})
+			continue
+		}
+
+		for _, l := range fileLangs {
+			code := l.Attributes.Code
+			conflict := func(reason string) {
+				report.Conflicts = append(report.Conflicts, CSVRowIssue{Line: line, Key: key, Language: code, ResourceString: s.ID, Reason: reason})
+			}
+
+			text := cell(code)
+			if text == "" {
+				report.Untranslated++
+				continue
+			}
+
+			// Parse the translation
+			var ps PluralStrings
+			if s.Attributes.Pluralized {
+				var ok bool
+				if _, ps, ok = parseICUPlural(text); !ok {
+					conflict("the translation of the pluralized string is not an ICU plural message")
+					continue
+				}
+				if missing := ps.MissingForms(l); len(missing) > 0 {
+					conflict(fmt.Sprintf("the plural forms %v are missed", missing))
+					continue
+				}
+			} else {
+				ps.Other = text
+			}
+
+			update := TranslationUpdate{
+				ResourceString: s.ID,
+				Key:            key,
+				Language:       l.ID,
+				Strings:        ps,
+				Reviewed:       parseTranslationsCSVFlag(cell(code + " reviewed")),
+				Proofread:      parseTranslationsCSVFlag(cell(code + " proofread")),
+			}
+
+			// Compare with the current translation
+			if tr, ok := translations[l.ID+"/"+s.ID]; ok && len(tr.Attributes.Strings.Categories()) > 0 {
+				if tr.Attributes.Strings == ps && tr.Attributes.Reviewed == update.Reviewed && tr.Attributes.Proofread == update.Proofread {
+					report.Unchanged++
+					continue
+				}
+				if modified := translationModified(tr).Format(time.RFC3339); cell(code+" modified") != modified {
+					conflict("the translation has changed since the export")
+					continue
+				}
+			}
+
+			report.Updates = append(report.Updates, update)
+		}
+	}
+
+	return report, nil
+}
+
+// The function prefixes the cell, which would be evaluated as a formula, with "'".
+// The cells already starting with "'" before such a character are prefixed too,
+// so that the prefix is removed unambiguously on import.
+func escapeTranslationsCSVCell(cell string) string {
+	if isTranslationsCSVFormula(cell) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// The function removes the prefix added by escapeTranslationsCSVCell
+func unescapeTranslationsCSVCell(cell string) string {
+	if strings.HasPrefix(cell, "'") && isTranslationsCSVFormula(cell) {
+		return cell[1:]
+	}
+	return cell
+}
+
+// The function checks whether the cell (without the leading "'") starts with a formula character
+func isTranslationsCSVFormula(cell string) bool {
+	cell = strings.TrimLeft(cell, "'")
+	return cell != "" && strings.ContainsRune(translationsCSVFormulaChars, rune(cell[0]))
+}
+
+// The function presents the strings as a CSV cell text
+func translationsCSVText(p PluralStrings, pluralized bool) string {
+	if pluralized {
+		return formatICUPlural(translationsCSVPluralVariable, p)
+	}
+	return p.Other
+}
+
+// The function presents a translation flag as a CSV cell text
+func translationsCSVFlag(v bool) string {
+	if v {
+		return "yes"
+	}
+	return ""
+}
+
+// The function parses a translation flag of a CSV cell
+func parseTranslationsCSVFlag(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "yes", "y", "true", "1", "x":
+		return true
+	default:
+		return false
+	}
+}
+
+// The function returns the time of the last modification of a translation
+func translationModified(tr ResourceTranslation) time.Time {
+	modified := tr.Attributes.DatetimeTranslated
+	for _, t := range []time.Time{tr.Attributes.DatetimeReviewed, tr.Attributes.DatetimeProofread} {
+		if t.After(modified) {
+			modified = t
+		}
+	}
+	return modified.UTC()
+}
@@ -0,0 +1,191 @@
+package transifex_api_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The TMXUploadResult struct stores the result of a processed TMX upload
+type TMXUploadResult struct {
+	ImportedUnits int             `json:"imported_units"` // the number of the imported translation units
+	Details       json.RawMessage `json:"details"`        // the details of the finished job as reported by the service
+}
+
+// Download the translation memory of a project for a language in the TMX format.
+// The file is streamed into the writer as soon as the service prepares it.
+// https://developers.transifex.com/reference/post_tmx-async-downloads
+func (t *TransifexApiClient) DownloadTranslationMemory(ctx context.Context, project, language string, w io.Writer) error {
+
+	body, err := t.createTmxDownloadRequestBody(project, language)
+	if err != nil {
+		return err
+	}
+
+	// Start the TMX file preparation
+	job, err := t.startAsyncJob(ctx, "/tmx_async_downloads", body)
+	if err != nil {
+		return err
+	}
+
+	// Wait until the file is ready
+	file, err := t.waitForAsyncDownload(ctx, "/tmx_async_downloads", job.ID)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Stream the file into the writer
+	n, err := io.Copy(w, file)
+	if err != nil {
+		t.l.Error(err)
+		return err
+	}
+
+	t.l.Debugf("%d bytes of the translation memory were downloaded", n)
+	return nil
+}
+
+// Upload a TMX file into the translation memory of a project for a language.
+// If overwrite is true, the existing translation memory units are replaced by the uploaded ones.
+// The function waits until the file is processed and returns the number of the imported units,
+// that is taken from the "imported_units" field of the details of the finished upload job
+// (https://developers.transifex.com/reference/get_tmx-async-uploads-tmx-async-upload-id).
+// https://developers.transifex.com/reference/post_tmx-async-uploads
+func (t *TransifexApiClient) UploadTranslationMemory(ctx context.Context, project, language string, r io.Reader, overwrite bool) (TMXUploadResult, error) {
+
+	// Check the mandatory parameters
+	if project == "" {
+		return TMXUploadResult{}, fmt.Errorf("mandatory parameter 'project' is missed")
+	}
+	if language == "" {
+		return TMXUploadResult{}, fmt.Errorf("mandatory parameter 'language' is missed")
+	}
+	if r == nil {
+		return TMXUploadResult{}, fmt.Errorf("mandatory parameter 'r' is missed")
+	}
+
+	// Stream the multipart form with the file, so that it is not buffered in memory
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := func() error {
+			if err := mw.WriteField("project", project); err != nil {
+				return err
+			}
+			if err := mw.WriteField("language", language); err != nil {
+				return err
+			}
+			if err := mw.WriteField("override", strconv.FormatBool(overwrite)); err != nil {
+				return err
+			}
+			fw, err := mw.CreateFormFile("content", "translation_memory.tmx")
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(fw, r); err != nil {
+				return err
+			}
+			return mw.Close()
+		}()
+		pw.CloseWithError(err)
+	}()
+
+	// Create an API request
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		strings.Join([]string{
+			t.apiURL,
+			"/tmx_async_uploads",
+		}, ""),
+		pr)
+	if err != nil {
+		pr.Close()
+		t.l.Error(err)
+		return TMXUploadResult{}, err
+	}
+
+	// Set authorization, Accept and Content-Type HTTP request headers
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	req.Header.Add("Accept", "application/vnd.api+json")
+	req.Header.Add("Content-Type", mw.FormDataContentType())
+
+	// Start the TMX file processing
+	job, err := t.doAsyncJobRequest(req)
+	if err != nil {
+		return TMXUploadResult{}, err
+	}
+
+	// Wait until the file is processed
+	job, err = t.waitForAsyncUpload(ctx, "/tmx_async_uploads", job.ID)
+	if err != nil {
+		return TMXUploadResult{}, err
+	}
+
+	return t.tmxUploadResult(job)
+}
+
+// The function checks the input set of parameters and converts it into a request body
+func (t *TransifexApiClient) createTmxDownloadRequestBody(project, language string) ([]byte, error) {
+	var body struct {
+		Data struct {
+			Type          string   `json:"type"`
+			Attributes    struct{} `json:"attributes"`
+			Relationships struct {
+				Project struct {
+					Data struct {
+						Type string `json:"type"`
+						ID   string `json:"id"`
+					} `json:"data"`
+				} `json:"project"`
+				Language struct {
+					Data LanguageRelationship `json:"data"`
+				} `json:"language"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	body.Data.Type = "tmx_async_downloads"
+
+	// Add mandatory project option
+	if project == "" {
+		return nil, fmt.Errorf("mandatory parameter 'project' is missed")
+	}
+	body.Data.Relationships.Project.Data.Type = "projects"
+	body.Data.Relationships.Project.Data.ID = project
+
+	// Add mandatory language option
+	if language == "" {
+		return nil, fmt.Errorf("mandatory parameter 'language' is missed")
+	}
+	body.Data.Relationships.Language.Data.Type = "languages"
+	body.Data.Relationships.Language.Data.ID = language
+
+	return json.Marshal(body)
+}
+
+// The function extracts the number of the imported units from the details of a finished TMX upload
+func (t *TransifexApiClient) tmxUploadResult(job AsyncJob) (TMXUploadResult, error) {
+	res := TMXUploadResult{Details: job.Attributes.Details}
+	if len(res.Details) == 0 {
+		return res, nil
+	}
+
+	var details struct {
+		ImportedUnits int `json:"imported_units"`
+	}
+	if err := json.Unmarshal(res.Details, &details); err != nil {
+		err = fmt.Errorf("unable to decode the details of TMX upload '%s': %s", job.ID, err.Error())
+		t.l.Error(err)
+		return res, err
+	}
+	res.ImportedUnits = details.ImportedUnits
+
+	t.l.Debugf("%d translation memory units were imported", res.ImportedUnits)
+	return res, nil
+}
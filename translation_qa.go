@@ -0,0 +1,392 @@
+package transifex_api_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The severity of a QA issue
+type QASeverity string
+
+const (
+	QAError   QASeverity = "error"
+	QAWarning QASeverity = "warning"
+	QAInfo    QASeverity = "info"
+	QAOff     QASeverity = "off" // the check is disabled
+)
+
+// The QA checks of the translations
+const (
+	QAPrintf       = "printf"        // "%s", "%1$d", "%.2f"
+	QAPythonFormat = "python-format" // "{}", "{0}", "{name!r}", "{name:>10}", "%(name)s"
+	QAICU          = "icu"           // "{name}", "{count, plural, ...}"
+	QALiquid       = "liquid"        // "{{ page.title }}", "{% include links.md %}"
+	QAMarkdown     = "markdown"      // inline code, link targets, emphasis, Pandoc attributes
+	QAHTML         = "html"          // "<b>", "</a>"
+	QANumbers      = "numbers"
+	QAURLs         = "urls"
+	QAPunctuation  = "punctuation" // the trailing punctuation
+	QAWhitespace   = "whitespace"  // the leading and trailing white spaces
+)
+
+// The default severities of the QA checks
+var defaultQASeverities = map[string]QASeverity{
+	QAPrintf:       QAError,
+	QAPythonFormat: QAError,
+	QAICU:          QAError,
+	QALiquid:       QAError,
+	QAMarkdown:     QAError,
+	QAHTML:         QAError,
+	QANumbers:      QAWarning,
+	QAURLs:         QAWarning,
+	QAPunctuation:  QAInfo,
+	QAWhitespace:   QAWarning,
+}
+
+// The options of the QA checks
+type QAOptions struct {
+	Severities map[string]QASeverity // the severities overriding the defaults, QAOff disables a check
+}
+
+// The QAIssue struct describes a difference between a source string and its translation
+type QAIssue struct {
+	ResourceString string     `json:"resource_string"`
+	Key            string     `json:"key"`
+	Language       string     `json:"language"`
+	Category       string     `json:"category"` // the plural form
+	Check          string     `json:"check"`
+	Severity       QASeverity `json:"severity"`
+	Missing        []string   `json:"missing,omitempty"` // the items of the source missed in the translation
+	Extra          []string   `json:"extra,omitempty"`   // the items of the translation missed in the source
+	Message        string     `json:"message"`
+}
+
+// The QAReport struct stores the result of the QA checks
+type QAReport struct {
+	Checked int       `json:"checked"` // the number of the checked translations
+	Issues  []QAIssue `json:"issues"`
+}
+
+var (
+	qaURL           = regexp.MustCompile(`(?:https?|ftp)://[^\s<>"'()\[\]]+|mailto:[^\s<>"'()\[\]]+`)
+	qaLiquid        = regexp.MustCompile(`\{\{.*?\}\}|\{%.*?%\}`)
+	qaPrintf        = regexp.MustCompile(`%(?:\d+\$)?[-+#0]*(?:\d+|\*)?(?:\.(?:\d+|\*))?(?:hh|h|ll|l|L|q|j|z|t)?[diouxXeEfFgGaAcsp@]`)
+	qaPythonNamed   = regexp.MustCompile(`%\([^)]+\)[-+#0 ]*\d*(?:\.\d+)?[diouxXeEfFgGcrsa]`)
+	qaPythonBrace   = regexp.MustCompile(`\{(?:\d*|[A-Za-z_]\w*(?:\.\w+|\[\w+\])*)(?:![rsa])?(?::[^{}]*)?\}`)
+	qaICU           = regexp.MustCompile(`\{\s*([A-Za-z_]\w*)\s*(?:\}|,\s*(\w+))`)
+	qaHTMLTag       = regexp.MustCompile(`</?([A-Za-z][\w:-]*)[^<>]*?/?>`)
+	qaMarkdownCode  = regexp.MustCompile("`+[^`]+`+")
+	qaMarkdownLink  = regexp.MustCompile(`\]\(([^()\s]*)(?:\s+"[^"]*")?\)|\]\[([^\]]*)\]`)
+	qaMarkdownEmph  = regexp.MustCompile(`\*\*|__`)
+	qaMarkdownAttrs = regexp.MustCompile(`\{:?\s*[.#][\w-][^{}]*\}`)
+	qaNumber        = regexp.MustCompile(`\d+(?:[.,\x{00A0}\x{202F} ]\d+)*`)
+)
+
+// The equivalent trailing punctuation characters of the different scripts
+var qaPunctuationClasses = map[rune]string{
+	'.': ".", '。': ".", '।': ".", '።': ".",
+	'!': "!", '！': "!",
+	'?': "?", '？': "?", '؟': "?", ';': ";", '；': ";",
+	':': ":", '：': ":",
+	'…': "…",
+}
+
+// The function checks the translations against their source strings: the placeholders
+// (printf, Python format, ICU and Liquid), the Markdown and HTML markup, the numbers,
+// the URLs, the trailing punctuation and the leading and trailing white spaces.
+// Each plural form of a translation is compared with the same form of the source string
+// (or with its "other" form, if the source string has no such form). The placeholders
+// of the source "other" form (e.g. the count "%d") are allowed in every plural form,
+// as a form may cover several numbers, e.g. the Ukrainian "one" form covers 1, 21, 31...
+func CheckTranslations(strs []ResourceString, trs []ResourceTranslation, opts QAOptions) QAReport {
+	var report QAReport
+
+	byID := map[string]*ResourceString{}
+	for i := range strs {
+		byID[strs[i].ID] = &strs[i]
+	}
+
+	for _, tr := range trs {
+		s, ok := byID[tr.Relationships.ResourceString.Data.ID]
+		if !ok || len(tr.Attributes.Strings.Categories()) == 0 {
+			continue
+		}
+		report.Checked++
+
+		pluralOther := ""
+		if s.Attributes.Pluralized {
+			pluralOther = s.Attributes.Strings.Other
+		}
+
+		for _, c := range tr.Attributes.Strings.Categories() {
+			source := s.Attributes.Strings.Get(c)
+			if source == "" {
+				source = s.Attributes.Strings.Other
+			}
+
+			for _, issue := range checkQAPair(source, tr.Attributes.Strings.Get(c), pluralOther, opts) {
+				issue.ResourceString = s.ID
+				issue.Key = s.Attributes.Key
+				issue.Language = tr.Relationships.Language.Data.ID
+				issue.Category = c
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+
+	return report
+}
+
+// The function returns the number of the issues of the severity
+func (r QAReport) Count(severity QASeverity) int {
+	n := 0
+	for _, i := range r.Issues {
+		if i.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// The function checks whether the report has any issues of the error severity
+func (r QAReport) HasErrors() bool {
+	return r.Count(QAError) > 0
+}
+
+// The function prints the QA report
+func (t *TransifexApiClient) PrintQAReport(r QAReport, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("QA report:\n")
+		fmt.Printf("  Checked: %v\n", r.Checked)
+		fmt.Printf("  Errors: %v\n", r.Count(QAError))
+		fmt.Printf("  Warnings: %v\n", r.Count(QAWarning))
+		fmt.Printf("  Issues:\n")
+		for _, i := range r.Issues {
+			fmt.Printf("    [%v] %v (%v, %v) %v: %v\n", i.Severity, i.Key, i.Language, i.Category, i.Check, i.Message)
+		}
+
+	case "json":
+		text2print, err := json.Marshal(r)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
+
+// The function compares a source text with its translation. For a plural form the pluralOther
+// is the "other" form of the source string, which placeholders are allowed in the translation.
+func checkQAPair(source, translation, pluralOther string, opts QAOptions) []QAIssue {
+	var issues []QAIssue
+
+	severity := func(check string) QASeverity {
+		if s, ok := opts.Severities[check]; ok {
+			return s
+		}
+		return defaultQASeverities[check]
+	}
+	compare := func(check string, src, tr, allowed []string) {
+		sev := severity(check)
+		if sev == QAOff {
+			return
+		}
+		missing, extra := qaDiff(src, tr)
+		extra, _ = qaDiff(extra, allowed)
+		if len(missing) == 0 && len(extra) == 0 {
+			return
+		}
+		var msg []string
+		if len(missing) > 0 {
+			msg = append(msg, "missed "+strings.Join(missing, ", "))
+		}
+		if len(extra) > 0 {
+			msg = append(msg, "unexpected "+strings.Join(extra, ", "))
+		}
+		issues = append(issues, QAIssue{
+			Check:    check,
+			Severity: sev,
+			Missing:  missing,
+			Extra:    extra,
+			Message:  strings.Join(msg, "; "),
+		})
+	}
+
+	src, tr := qaExtract(source), qaExtract(translation)
+	var other qaItems
+	if pluralOther != "" {
+		other = qaExtract(pluralOther)
+	}
+	compare(QALiquid, src.liquid, tr.liquid, other.liquid)
+	compare(QAPrintf, src.printf, tr.printf, other.printf)
+	compare(QAPythonFormat, src.python, tr.python, other.python)
+	compare(QAICU, src.icu, tr.icu, other.icu)
+	compare(QAHTML, src.html, tr.html, nil)
+	compare(QAMarkdown, src.markdown, tr.markdown, nil)
+	compare(QAURLs, src.urls, tr.urls, nil)
+
+	// The plural forms usually replace the number with a word, e.g. "one file"
+	if pluralOther == "" {
+		compare(QANumbers, src.numbers, tr.numbers, nil)
+	}
+
+	if sev := severity(QAPunctuation); sev != QAOff {
+		sp, tp := qaTrailingPunctuation(source), qaTrailingPunctuation(translation)
+		if sp != tp {
+			issues = append(issues, QAIssue{
+				Check:    QAPunctuation,
+				Severity: sev,
+				Message:  fmt.Sprintf("the trailing punctuation %q differs from the source %q", tp, sp),
+			})
+		}
+	}
+
+	if sev := severity(QAWhitespace); sev != QAOff {
+		sl, sr := qaSurroundingSpace(source)
+		tl, tr := qaSurroundingSpace(translation)
+		if sl != tl || sr != tr {
+			issues = append(issues, QAIssue{
+				Check:    QAWhitespace,
+				Severity: sev,
+				Message:  fmt.Sprintf("the leading and trailing white spaces %q, %q differ from the source %q, %q", tl, tr, sl, sr),
+			})
+		}
+	}
+
+	return issues
+}
+
+// The items of a text compared by the QA checks
+type qaItems struct {
+	liquid, printf, python, icu, html, markdown, urls, numbers []string
+}
+
+// The function extracts the items of a text. The found items are masked,
+// so they are not found again by the following checks (e.g. the digits of "%1$s" are not numbers).
+func qaExtract(text string) qaItems {
+	var items qaItems
+
+	take := func(re *regexp.Regexp, f func(m []string)) {
+		text = re.ReplaceAllStringFunc(text, func(s string) string {
+			f(re.FindStringSubmatch(s))
+			return strings.Repeat(" ", len(s))
+		})
+	}
+
+	// The white spaces inside the Liquid tags are not significant
+	take(qaLiquid, func(m []string) {
+		tag := m[0]
+		inner := strings.Join(strings.Fields(tag[2:len(tag)-2]), " ")
+		items.liquid = append(items.liquid, tag[:2]+" "+inner+" "+tag[len(tag)-2:])
+	})
+	take(qaMarkdownCode, func(m []string) {
+		items.markdown = append(items.markdown, m[0])
+	})
+	// The Markdown links are taken with their targets before the bare URLs,
+	// so that a link turned into a plain URL is reported
+	take(qaMarkdownLink, func(m []string) {
+		switch {
+		case m[2] != "":
+			items.markdown = append(items.markdown, "["+m[2]+"]")
+		default:
+			items.markdown = append(items.markdown, "("+m[1]+")")
+		}
+	})
+	take(qaURL, func(m []string) {
+		items.urls = append(items.urls, strings.TrimRight(m[0], ".,;:!?"))
+	})
+	take(qaMarkdownAttrs, func(m []string) {
+		items.markdown = append(items.markdown, m[0])
+	})
+	take(qaMarkdownEmph, func(m []string) {
+		items.markdown = append(items.markdown, m[0])
+	})
+	take(qaHTMLTag, func(m []string) {
+		tag := "<" + strings.ToLower(m[1]) + ">"
+		if strings.HasPrefix(m[0], "</") {
+			tag = "</" + strings.ToLower(m[1]) + ">"
+		}
+		items.html = append(items.html, tag)
+	})
+	take(qaPythonNamed, func(m []string) {
+		items.python = append(items.python, m[0])
+	})
+	take(qaPrintf, func(m []string) {
+		items.printf = append(items.printf, m[0])
+	})
+	// The named "{name}" fields are taken as ICU arguments, the other ones as Python fields
+	take(qaICU, func(m []string) {
+		items.icu = append(items.icu, "{"+m[1]+"}")
+	})
+	take(qaPythonBrace, func(m []string) {
+		items.python = append(items.python, m[0])
+	})
+	take(qaNumber, func(m []string) {
+		items.numbers = append(items.numbers, strings.Map(func(r rune) rune {
+			if unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, m[0]))
+	})
+
+	return items
+}
+
+// The function compares two multisets of items
+func qaDiff(src, tr []string) ([]string, []string) {
+	counts := map[string]int{}
+	for _, s := range src {
+		counts[s]++
+	}
+	for _, t := range tr {
+		counts[t]--
+	}
+
+	var missing, extra []string
+	for item, n := range counts {
+		for ; n > 0; n-- {
+			missing = append(missing, item)
+		}
+		for ; n < 0; n++ {
+			extra = append(extra, item)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
+
+// The function returns the class of the trailing punctuation of a text
+func qaTrailingPunctuation(text string) string {
+	text = strings.TrimRightFunc(text, unicode.IsSpace)
+	if strings.HasSuffix(text, "...") {
+		return "…"
+	}
+	for r, class := range qaPunctuationClasses {
+		if strings.HasSuffix(text, string(r)) {
+			return class
+		}
+	}
+	return ""
+}
+
+// The function returns the leading and trailing white spaces of a text
+func qaSurroundingSpace(text string) (string, string) {
+	trimmed := strings.TrimLeftFunc(text, unicode.IsSpace)
+	leading := text[:len(text)-len(trimmed)]
+	if trimmed == "" {
+		return leading, ""
+	}
+	rest := strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	return leading, trimmed[len(rest):]
+}
@@ -0,0 +1,48 @@
+package transifex_api_client
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+// The function returns the sorted checks of the issues with the severity
+func qaIssueChecks(issues []QAIssue) string {
+	var checks []string
+	for _, i := range issues {
+		checks = append(checks, i.Check+":"+string(i.Severity))
+	}
+	sort.Strings(checks)
+	return strings.Join(checks, ",")
+}
+
+func TestCheckQAPairMarkdownLinks(t *testing.T) {
+	source := "Read [the docs](https://carpentries.org/docs)."
+	for translation, expected := range map[string]string{
+		"Прочитайте [документацію](https://carpentries.org/docs).": "",
+		"Прочитайте документацію https://carpentries.org/docs.":    "markdown:" + string(QAError) + ",urls:" + string(QAWarning),
+		"Прочитайте документацію.":                                 "markdown:" + string(QAError),
+	} {
+		if got := qaIssueChecks(checkQAPair(source, translation, "", QAOptions{})); got != expected {
+			t.Errorf("%q: issues %q, expected %q", translation, got, expected)
+		}
+	}
+}
+
+func TestCheckQAPairPluralForms(t *testing.T) {
+	// The Ukrainian "one" form covers 21, 31... and contains the count
+	if issues := checkQAPair("One file", "%d файл", "%d files", QAOptions{}); len(issues) > 0 {
+		t.Errorf("unexpected issues of the plural form: %+v", issues)
+	}
+
+	// The placeholders, that are not in the source "other" form, are still reported
+	issues := checkQAPair("One file", "%d файл %s", "%d files", QAOptions{})
+	if got := qaIssueChecks(issues); got != "printf:"+string(QAError) {
+		t.Errorf("issues %q, expected the unexpected %%s", got)
+	}
+
+	// The strings, that are not pluralized, are compared with the source only
+	if got := qaIssueChecks(checkQAPair("One file", "%d файл", "", QAOptions{})); got != "printf:"+string(QAError) {
+		t.Errorf("issues %q, expected the unexpected %%d", got)
+	}
+}
@@ -0,0 +1,61 @@
+package transifex_api_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+// The TranslationUpdate struct stores a translation of a resource string
+// read from a local file (PO, XLIFF, CSV, etc.), that should be sent to the service
+type TranslationUpdate struct {
+	ResourceString string        `json:"resource_string"` // the ID of the resource string
+	Key            string        `json:"key"`
+	Language       string        `json:"language"` // the ID of the language, e.g. "l:uk"
+	Strings        PluralStrings `json:"strings"`
+	Reviewed       bool          `json:"reviewed"`
+	Proofread      bool          `json:"proofread"`
+}
+
+// The function maps the translations of the resource strings to the keys of the strings.
+// It is used to rebuild the local files with the translations downloaded from the service.
+func TranslationsByKey(strs []ResourceString, trs []ResourceTranslation) map[string]PluralStrings {
+	keys := map[string]string{}
+	for _, s := range strs {
+		keys[s.ID] = s.Attributes.Key
+	}
+
+	translations := map[string]PluralStrings{}
+	for _, tr := range trs {
+		if key, ok := keys[tr.Relationships.ResourceString.Data.ID]; ok {
+			translations[key] = tr.Attributes.Strings
+		}
+	}
+	return translations
+}
+
+// The function prints the information about a translation update
+func (t *TransifexApiClient) PrintTranslationUpdate(u TranslationUpdate, formatter string) {
+
+	switch formatter {
+
+	case "text":
+		fmt.Printf("Translation update information:\n")
+		fmt.Printf("  ResourceString: %v\n", u.ResourceString)
+		fmt.Printf("  Key: %v\n", u.Key)
+		fmt.Printf("  Language: %v\n", u.Language)
+		fmt.Printf("  Strings:\n")
+		printPluralStrings(u.Strings, "    ")
+		fmt.Printf("  Reviewed: %v\n", u.Reviewed)
+		fmt.Printf("  Proofread: %v\n", u.Proofread)
+
+	case "json":
+		text2print, err := json.Marshal(u)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(text2print))
+
+	default:
+	}
+}
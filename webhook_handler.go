@@ -0,0 +1,220 @@
+package transifex_api_client
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	ErrWebhookNoSignature      = errors.New("webhook request has no signature")
+	ErrWebhookInvalidSignature = errors.New("webhook request has invalid signature")
+	ErrWebhookInvalidDate      = errors.New("webhook request has invalid date")
+	ErrWebhookStaleRequest     = errors.New("webhook request is too old")
+)
+
+// The maximum size of a webhook request body
+const webhookMaxBodySize = 1 << 20
+
+// The WebhookEvent struct stores the payload of a webhook request
+type WebhookEvent struct {
+	Event        WebhookEventType `json:"event"`
+	Organization string           `json:"organization"`
+	Project      string           `json:"project"`
+	Resource     string           `json:"resource"`
+	Language     string           `json:"language"`
+	Translated   int              `json:"translated"`
+	Reviewed     int              `json:"reviewed"`
+	Proofread    int              `json:"proofread"`
+	Raw          json.RawMessage  `json:"-"`
+}
+
+// The function processes a webhook event. If it returns an error, the handler responds
+// with the 500 status code, so that the service retries the request later.
+type WebhookCallback func(WebhookEvent) error
+
+// The WebhookHandler is an http.Handler, that receives the project webhook requests,
+// verifies their signatures and dispatches the events to the registered callbacks.
+type WebhookHandler struct {
+	// The maximum allowed difference between the request date and the current time
+	MaxAge time.Duration
+
+	// The function returns the current time (time.Now, if not set)
+	Now func() time.Time
+
+	// The logger of the callback errors (the standard logrus logger, if not set)
+	ErrorLog logrus.FieldLogger
+
+	secret    []byte
+	mu        sync.RWMutex
+	callbacks map[WebhookEventType][]WebhookCallback
+	fallback  []WebhookCallback
+}
+
+// The function returns a new webhook handler, that verifies the requests with the shared secret.
+// The requests older than 5 minutes are rejected by default.
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		MaxAge:    5 * time.Minute,
+		secret:    []byte(secret),
+		callbacks: map[WebhookEventType][]WebhookCallback{},
+	}
+}
+
+// Register a callback for an event type
+func (h *WebhookHandler) Handle(event WebhookEventType, cb WebhookCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.callbacks[event] = append(h.callbacks[event], cb)
+}
+
+// Register a callback for all the events
+func (h *WebhookHandler) HandleAll(cb WebhookCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = append(h.fallback, cb)
+}
+
+// The function processes a webhook request
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	// The service sends the webhooks with the POST method only
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read the request body
+	body, err := io.ReadAll(io.LimitReader(r.Body, webhookMaxBodySize+1))
+	if err != nil {
+		http.Error(w, "unable to read the request body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > webhookMaxBodySize {
+		http.Error(w, "request body is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Check the signature and the date of the request
+	if err := h.Verify(r.Header, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// Decode the event
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Dispatch the event to the callbacks
+	// The callback errors are logged, but not disclosed to the client
+	if err := h.dispatch(event); err != nil {
+		h.errorLog().WithField("event", event.Event).Error(err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// The function returns the logger of the callback errors
+func (h *WebhookHandler) errorLog() logrus.FieldLogger {
+	if h.ErrorLog != nil {
+		return h.ErrorLog
+	}
+	return logrus.StandardLogger()
+}
+
+// The function checks the signature (X-TX-Signature-V2 header) and the freshness
+// (Date header) of a webhook request
+func (h *WebhookHandler) Verify(header http.Header, body []byte) error {
+	signature := header.Get("X-TX-Signature-V2")
+	if signature == "" {
+		return ErrWebhookNoSignature
+	}
+
+	// Check the date of the request
+	date := header.Get("Date")
+	reqTime, err := http.ParseTime(date)
+	if err != nil {
+		return ErrWebhookInvalidDate
+	}
+
+	now := time.Now()
+	if h.Now != nil {
+		now = h.Now()
+	}
+	if h.MaxAge > 0 {
+		age := now.Sub(reqTime)
+		if age < 0 {
+			age = -age
+		}
+		if age > h.MaxAge {
+			return ErrWebhookStaleRequest
+		}
+	}
+
+	// Check the signature of the request
+	expected := WebhookSignatureV2(string(h.secret), header.Get("X-TX-Url"), date, body)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return ErrWebhookInvalidSignature
+	}
+
+	return nil
+}
+
+// The function calculates the signature of a webhook request:
+// base64(HMAC-SHA256(secret, "POST\n" + url + "\n" + date + "\n" + hex(MD5(body))))
+func WebhookSignatureV2(secret, url, date string, body []byte) string {
+	sum := md5.Sum(body)
+	msg := strings.Join([]string{"POST", url, date, hex.EncodeToString(sum[:])}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(msg))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// The function decodes the payload of a webhook request
+func ParseWebhookEvent(body []byte) (WebhookEvent, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return WebhookEvent{}, fmt.Errorf("unable to decode the webhook payload: %s", err.Error())
+	}
+	if event.Event == "" {
+		return WebhookEvent{}, fmt.Errorf("the webhook payload has no event type")
+	}
+	event.Raw = json.RawMessage(body)
+	return event, nil
+}
+
+// The function calls the callbacks registered for the event type and the ones registered for all the events
+func (h *WebhookHandler) dispatch(event WebhookEvent) error {
+	h.mu.RLock()
+	cbs := make([]WebhookCallback, 0, len(h.callbacks[event.Event])+len(h.fallback))
+	cbs = append(cbs, h.callbacks[event.Event]...)
+	cbs = append(cbs, h.fallback...)
+	h.mu.RUnlock()
+
+	var errs []error
+	for _, cb := range cbs {
+		if err := cb(event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,142 @@
+package transifex_api_client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+)
+
+const (
+	testWebhookSecret = "secret"
+	testWebhookURL    = "https://example.org/transifex/webhook"
+)
+
+var testWebhookNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// The function returns a handler, which current time is testWebhookNow
+func newTestWebhookHandler() (*WebhookHandler, *logrustest.Hook) {
+	logger, hook := logrustest.NewNullLogger()
+	h := NewWebhookHandler(testWebhookSecret)
+	h.Now = func() time.Time { return testWebhookNow }
+	h.ErrorLog = logger
+	return h, hook
+}
+
+// The function replays the recorded payload signed at the given time
+func newTestWebhookRequest(body string, date time.Time) *http.Request {
+	date = date.UTC()
+	dateStr := date.Format(http.TimeFormat)
+	req := httptest.NewRequest(http.MethodPost, testWebhookURL, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-TX-Url", testWebhookURL)
+	req.Header.Set("Date", dateStr)
+	req.Header.Set("X-TX-Signature-V2", WebhookSignatureV2(testWebhookSecret, testWebhookURL, dateStr, []byte(body)))
+	return req
+}
+
+// The function reads the recorded webhook payload
+func readTestWebhookPayload(t *testing.T) string {
+	body, err := os.ReadFile("testdata/webhook_translation_completed.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestWebhookHandlerDispatch(t *testing.T) {
+	h, _ := newTestWebhookHandler()
+	var received []WebhookEvent
+	h.Handle(WebhookTranslationCompleted, func(e WebhookEvent) error {
+		received = append(received, e)
+		return nil
+	})
+	h.Handle(WebhookReviewCompleted, func(e WebhookEvent) error {
+		t.Errorf("the callback of another event type is called")
+		return nil
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newTestWebhookRequest(readTestWebhookPayload(t), testWebhookNow.Add(-time.Minute)))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status %d, expected %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if len(received) != 1 {
+		t.Fatalf("%d events are dispatched, expected 1", len(received))
+	}
+	e := received[0]
+	if e.Project != "lesson" || e.Resource != "episode-01" || e.Language != "uk" || e.Translated != 100 {
+		t.Errorf("unexpected event %+v", e)
+	}
+}
+
+func TestWebhookHandlerRejectsTamperedBody(t *testing.T) {
+	h, _ := newTestWebhookHandler()
+	h.HandleAll(func(e WebhookEvent) error {
+		t.Errorf("the tampered event is dispatched")
+		return nil
+	})
+
+	body := readTestWebhookPayload(t)
+	req := newTestWebhookRequest(body, testWebhookNow)
+	tampered := strings.Replace(body, `"translated":100`, `"translated":99`, 1)
+	req.Body = httptest.NewRequest(http.MethodPost, testWebhookURL, strings.NewReader(tampered)).Body
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status %d, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestWebhookHandlerRejectsStaleRequests(t *testing.T) {
+	h, _ := newTestWebhookHandler()
+	h.HandleAll(func(e WebhookEvent) error {
+		t.Errorf("the stale event is dispatched")
+		return nil
+	})
+	body := readTestWebhookPayload(t)
+
+	// The request signed too long ago
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newTestWebhookRequest(body, testWebhookNow.Add(-h.MaxAge-time.Second)))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("stale request: status %d, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+
+	// The request without the date
+	req := newTestWebhookRequest(body, testWebhookNow)
+	req.Header.Del("Date")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("request without date: status %d, expected %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestWebhookHandlerCallbackError(t *testing.T) {
+	h, hook := newTestWebhookHandler()
+	h.HandleAll(func(e WebhookEvent) error {
+		return errors.New("database is not available")
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newTestWebhookRequest(readTestWebhookPayload(t), testWebhookNow))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status %d, expected %d", rec.Code, http.StatusInternalServerError)
+	}
+	if strings.Contains(rec.Body.String(), "database") {
+		t.Errorf("the callback error is disclosed in the response: %q", rec.Body.String())
+	}
+	if e := hook.LastEntry(); e == nil || e.Level != logrus.ErrorLevel || !strings.Contains(e.Message, "database is not available") {
+		t.Errorf("the callback error is not logged: %v", hook.AllEntries())
+	}
+}
@@ -0,0 +1,761 @@
+package transifex_api_client
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The version of the XLIFF format
+type XLIFFVersion string
+
+const (
+	XLIFFVersion12 XLIFFVersion = "1.2"
+	XLIFFVersion20 XLIFFVersion = "2.0"
+)
+
+// The namespaces of the XLIFF versions
+const (
+	xliff12Namespace = "urn:oasis:names:tc:xliff:document:1.2"
+	xliff20Namespace = "urn:oasis:names:tc:xliff:document:2.0"
+)
+
+// The markers of the plural groups: the 1.2 restype and the 2.0 type of the group element.
+// The other groups (e.g. the files or the sections of the CAT tools) are transparent.
+const (
+	xliff12PluralGroup = "x-gettext-plurals"
+	xliff20PluralGroup = "tx:plurals"
+)
+
+// The inline code elements of both XLIFF versions, that are replaced with their
+// textual equivalent ("equiv-text" in 1.2, "equiv" in 2.0) or their content
+var xliffInlineCodes = map[string]bool{
+	"x": true, "bx": true, "ex": true, "ph": true, "bpt": true, "ept": true, "it": true, // 1.2
+	"sc": true, "ec": true, // 2.0
+}
+
+// The options of the XLIFF file export
+type XLIFFExportOptions struct {
+	Version        XLIFFVersion // XLIFFVersion12, if not set
+	SourceLanguage string       // the code of the source language ("en", if not set)
+	Original       string       // the name of the original file (the resource), e.g. "episodes/intro.md"
+}
+
+// The XLIFFUnit struct stores a translation unit read from an XLIFF file.
+// The plural forms of a string are stored in separate units of the same plural group,
+// named after the CLDR plural categories.
+type XLIFFUnit struct {
+	ID        string
+	Name      string // resname (1.2) or name (2.0): the key of the string or the plural category
+	Group     string // the ID of the enclosing plural group (if any)
+	GroupName string // the name of the enclosing plural group: the key of the string
+	Source    string
+	Target    string
+	State     string // "initial", "translated", "reviewed" or "final" (normalized to XLIFF 2.0 states)
+	Notes     []string
+
+	invalid string // the reason, why the texts of the unit cannot be imported (if any)
+}
+
+// The XLIFFDocument struct stores the units of an XLIFF file
+type XLIFFDocument struct {
+	Version        XLIFFVersion
+	SourceLanguage string
+	TargetLanguage string
+	Units          []XLIFFUnit
+}
+
+// The XLIFFUnitIssue struct describes an XLIFF unit, that was not converted into a translation update
+type XLIFFUnitIssue struct {
+	Unit           XLIFFUnit
+	ResourceString string // the ID of the matched resource string (if any)
+	Reason         string
+}
+
+// The XLIFFImportReport struct stores the result of matching an XLIFF file against the resource strings
+type XLIFFImportReport struct {
+	Updates      []TranslationUpdate
+	Unmatched    []XLIFFUnitIssue // the units without a matching resource string
+	Conflicts    []XLIFFUnitIssue // the units, that match a resource string, but cannot be imported safely
+	Untranslated int              // the number of skipped units without translation
+	Unchanged    int              // the number of units equal to the current translations
+}
+
+// The XML model shared by both XLIFF versions: a file or a group contains units and groups
+type xliffNode struct {
+	XMLName  xml.Name
+	ID       string `xml:"id,attr,omitempty"`
+	Name     string `xml:"name,attr,omitempty"`      // 2.0
+	Resname  string `xml:"resname,attr,omitempty"`   // 1.2
+	Restype  string `xml:"restype,attr,omitempty"`   // 1.2
+	Type     string `xml:"type,attr,omitempty"`      // 2.0
+	Approved string `xml:"approved,attr,omitempty"`  // 1.2
+	Maxwidth string `xml:"maxwidth,attr,omitempty"`  // 1.2
+	SizeUnit string `xml:"size-unit,attr,omitempty"` // 1.2
+
+	// 1.2 trans-unit content
+	Source        *xliffContent       `xml:"source"`
+	Target        *xliffContent       `xml:"target"`
+	Notes         []xliffNote         `xml:"note"`
+	ContextGroups []xliff12ContextGrp `xml:"context-group"`
+
+	// 2.0 unit content
+	NotesGroup *struct {
+		Notes []xliffNote `xml:"note"`
+	} `xml:"notes"`
+	Segments []xliffSegment `xml:"segment"`
+
+	// 1.2 body and group, 2.0 group content
+	Nodes []xliffNode `xml:",any"`
+}
+
+type xliffSegment struct {
+	State  string        `xml:"state,attr,omitempty"`
+	Source *xliffContent `xml:"source"`
+	Target *xliffContent `xml:"target"`
+}
+
+type xliffContent struct {
+	State string `xml:"state,attr,omitempty"` // 1.2
+	Inner string `xml:",innerxml"`
+}
+
+type xliffNote struct {
+	From     string `xml:"from,attr,omitempty"`     // 1.2
+	Category string `xml:"category,attr,omitempty"` // 2.0
+	Text     string `xml:",chardata"`
+}
+
+type xliff12ContextGrp struct {
+	Purpose  string `xml:"purpose,attr,omitempty"`
+	Contexts []struct {
+		Type string `xml:"context-type,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"context"`
+}
+
+type xliffRoot struct {
+	XMLName        xml.Name
+	Version        string      `xml:"version,attr"`
+	SourceLanguage string      `xml:"srcLang,attr,omitempty"` // 2.0
+	TargetLanguage string      `xml:"trgLang,attr,omitempty"` // 2.0
+	Files          []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	SourceLanguage string      `xml:"source-language,attr,omitempty"` // 1.2
+	TargetLanguage string      `xml:"target-language,attr,omitempty"` // 1.2
+	Body           *xliffNode  `xml:"body"`                           // 1.2
+	Nodes          []xliffNode `xml:",any"`                           // 2.0
+}
+
+// The function writes a bilingual XLIFF file of the resource strings translated into the language.
+// The notes are filled from the developer comments and the instructions of the strings,
+// the states of the units are derived from the reviewed and proofread flags of the translations.
+func WriteXLIFF(w io.Writer, strs []ResourceString, trs []ResourceTranslation, lang Language, opts XLIFFExportOptions) error {
+	version := opts.Version
+	if version == "" {
+		version = XLIFFVersion12
+	}
+	if version != XLIFFVersion12 && version != XLIFFVersion20 {
+		return fmt.Errorf("unknown 'Version' value")
+	}
+	source := opts.SourceLanguage
+	if source == "" {
+		source = "en"
+	}
+	original := opts.Original
+	if original == "" {
+		original = "resource"
+	}
+
+	// Index the translations by the resource string ID
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	// Convert the strings into units and groups
+	var nodes []xliffNode
+	for _, s := range sortResourceStrings(strs) {
+		tr, translated := translations[s.ID]
+		id := xliffUnitID(s)
+
+		if !s.Attributes.Pluralized {
+			target := ""
+			if translated {
+				target = tr.Attributes.Strings.Other
+			}
+			nodes = append(nodes, newXLIFFUnitNode(version, id, s.Attributes.Key, s, s.Attributes.Strings.Other, target, tr, true))
+			continue
+		}
+
+		// Plural forms are exported as a group of units, one per plural category of the language
+		group := xliffNode{XMLName: xml.Name{Local: "group"}, ID: id}
+		if version == XLIFFVersion12 {
+			group.Resname = s.Attributes.Key
+			group.Restype = xliff12PluralGroup
+		} else {
+			group.Name = s.Attributes.Key
+			group.Type = xliff20PluralGroup
+		}
+		for i, c := range lang.RequiredPluralForms() {
+			src := s.Attributes.Strings.Get(c)
+			if src == "" {
+				src = s.Attributes.Strings.Other
+			}
+			target := ""
+			if translated {
+				target = tr.Attributes.Strings.Get(c)
+			}
+			group.Nodes = append(group.Nodes, newXLIFFUnitNode(version, id+"-"+c, c, s, src, target, tr, i == 0))
+		}
+		nodes = append(nodes, group)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(bw)
+	enc.Indent("", "  ")
+	if err := writeXLIFFDocument(enc, version, source, lang.Attributes.Code, original, nodes); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	bw.WriteString("\n")
+
+	return bw.Flush()
+}
+
+// The function writes the root and the file elements of an XLIFF document with the units
+func writeXLIFFDocument(enc *xml.Encoder, version XLIFFVersion, source, target, original string, nodes []xliffNode) error {
+	attr := func(name, value string) xml.Attr {
+		return xml.Attr{Name: xml.Name{Local: name}, Value: value}
+	}
+
+	root := xml.StartElement{Name: xml.Name{Local: "xliff"}}
+	file := xml.StartElement{Name: xml.Name{Local: "file"}}
+	body := xml.StartElement{Name: xml.Name{Local: "body"}}
+
+	if version == XLIFFVersion12 {
+		root.Attr = []xml.Attr{attr("xmlns", xliff12Namespace), attr("version", "1.2")}
+		file.Attr = []xml.Attr{
+			attr("original", original),
+			attr("source-language", source),
+			attr("target-language", target),
+			attr("datatype", "plaintext"),
+		}
+	} else {
+		root.Attr = []xml.Attr{attr("xmlns", xliff20Namespace), attr("version", "2.0"),
+			attr("srcLang", source), attr("trgLang", target)}
+		file.Attr = []xml.Attr{attr("id", xliffToken(original)), attr("original", original)}
+	}
+
+	// Open the elements
+	starts := []xml.StartElement{root, file}
+	if version == XLIFFVersion12 {
+		starts = append(starts, body)
+	}
+	for _, st := range starts {
+		if err := enc.EncodeToken(st); err != nil {
+			return err
+		}
+	}
+
+	// Write the units and the groups
+	for _, n := range nodes {
+		if err := enc.Encode(n); err != nil {
+			return err
+		}
+	}
+
+	// Close the elements
+	for i := len(starts) - 1; i >= 0; i-- {
+		if err := enc.EncodeToken(starts[i].End()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The function creates a unit of the XLIFF file.
+// The notes and the limits are added to the first unit of a plural group only.
+func newXLIFFUnitNode(version XLIFFVersion, id, name string, s ResourceString, source, target string, tr ResourceTranslation, withNotes bool) xliffNode {
+	a := s.Attributes
+	n := xliffNode{ID: id}
+	state := xliffState(target, tr)
+
+	// The notes of the unit: the 1.2 "from" attribute or the 2.0 "category" attribute
+	// stores the origin of a note
+	var notes []xliffNote
+	addNote := func(origin, text string) {
+		if text == "" || !withNotes {
+			return
+		}
+		if version == XLIFFVersion12 {
+			notes = append(notes, xliffNote{From: origin, Text: text})
+		} else {
+			notes = append(notes, xliffNote{Category: origin, Text: text})
+		}
+	}
+	addNote("developer", a.DeveloperComment)
+	addNote("instructions", a.Instructions)
+	if version == XLIFFVersion20 {
+		addNote("context", a.Context)
+	}
+
+	if version == XLIFFVersion12 {
+		n.XMLName.Local = "trans-unit"
+		n.Resname = name
+		n.Source = &xliffContent{Inner: xliffEscape(source)}
+		if target != "" {
+			n.Target = &xliffContent{State: xliff12State(state), Inner: xliffEscape(target)}
+		}
+		if state == "reviewed" || state == "final" {
+			n.Approved = "yes"
+		}
+		if a.CharacterLimit > 0 {
+			n.Maxwidth = strconv.Itoa(a.CharacterLimit)
+			n.SizeUnit = "char"
+		}
+		n.Notes = notes
+		if a.Context != "" && withNotes {
+			g := xliff12ContextGrp{Purpose: "information"}
+			g.Contexts = append(g.Contexts, struct {
+				Type string `xml:"context-type,attr"`
+				Text string `xml:",chardata"`
+			}{"x-context", a.Context})
+			n.ContextGroups = append(n.ContextGroups, g)
+		}
+		return n
+	}
+
+	n.XMLName.Local = "unit"
+	n.Name = name
+	if len(notes) > 0 {
+		n.NotesGroup = &struct {
+			Notes []xliffNote `xml:"note"`
+		}{notes}
+	}
+	seg := xliffSegment{State: state, Source: &xliffContent{Inner: xliffEscape(source)}}
+	if target != "" {
+		seg.Target = &xliffContent{Inner: xliffEscape(target)}
+	}
+	n.Segments = []xliffSegment{seg}
+	return n
+}
+
+// The function parses an XLIFF 1.2 or 2.0 file
+func ReadXLIFF(r io.Reader) (XLIFFDocument, error) {
+	var root xliffRoot
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return XLIFFDocument{}, fmt.Errorf("unable to decode the XLIFF file: %s", err.Error())
+	}
+	if root.XMLName.Local != "xliff" {
+		return XLIFFDocument{}, fmt.Errorf("the root element is '%s' instead of 'xliff'", root.XMLName.Local)
+	}
+
+	doc := XLIFFDocument{Version: XLIFFVersion(root.Version)}
+	switch {
+	case strings.HasPrefix(root.Version, "1."):
+		doc.Version = XLIFFVersion12
+	case strings.HasPrefix(root.Version, "2."):
+		doc.Version = XLIFFVersion20
+		doc.SourceLanguage = root.SourceLanguage
+		doc.TargetLanguage = root.TargetLanguage
+	default:
+		return XLIFFDocument{}, fmt.Errorf("unsupported XLIFF version '%s'", root.Version)
+	}
+
+	for _, f := range root.Files {
+		if doc.Version == XLIFFVersion12 {
+			if doc.SourceLanguage == "" {
+				doc.SourceLanguage = f.SourceLanguage
+				doc.TargetLanguage = f.TargetLanguage
+			}
+			if f.Body == nil {
+				continue
+			}
+			units, err := collectXLIFFUnits(f.Body.Nodes, doc.Version, nil)
+			if err != nil {
+				return XLIFFDocument{}, err
+			}
+			doc.Units = append(doc.Units, units...)
+			continue
+		}
+
+		units, err := collectXLIFFUnits(f.Nodes, doc.Version, nil)
+		if err != nil {
+			return XLIFFDocument{}, err
+		}
+		doc.Units = append(doc.Units, units...)
+	}
+
+	return doc, nil
+}
+
+// The function converts the XML units of a file or a group into XLIFFUnit values.
+// The group is the enclosing plural group (if any).
+func collectXLIFFUnits(nodes []xliffNode, version XLIFFVersion, group *xliffNode) ([]XLIFFUnit, error) {
+	var units []XLIFFUnit
+	for _, n := range nodes {
+		switch n.XMLName.Local {
+
+		case "group":
+			plural := group
+			if n.Restype == xliff12PluralGroup || n.Type == xliff20PluralGroup {
+				plural = &n
+			}
+			sub, err := collectXLIFFUnits(n.Nodes, version, plural)
+			if err != nil {
+				return nil, err
+			}
+			units = append(units, sub...)
+
+		case "trans-unit":
+			u := XLIFFUnit{ID: n.ID, Name: n.Resname}
+			if n.Source != nil {
+				u.Source = u.plainText(n.Source.Inner)
+			}
+			u.State = "initial"
+			if n.Target != nil {
+				u.Target = u.plainText(n.Target.Inner)
+				u.State = xliffNormalizeState(n.Target.State, u.Target)
+			}
+			if n.Approved == "yes" && u.Target != "" && u.State != "final" {
+				u.State = "reviewed"
+			}
+			for _, note := range n.Notes {
+				u.Notes = append(u.Notes, note.Text)
+			}
+			if group != nil {
+				u.Group = group.ID
+				u.GroupName = group.Resname
+			}
+			units = append(units, u)
+
+		case "unit":
+			u := XLIFFUnit{ID: n.ID, Name: n.Name, State: "initial"}
+			for _, seg := range n.Segments {
+				if seg.Source != nil {
+					u.Source += u.plainText(seg.Source.Inner)
+				}
+				if seg.Target != nil {
+					u.Target += u.plainText(seg.Target.Inner)
+				}
+				if seg.State != "" {
+					u.State = seg.State
+				}
+			}
+			if u.Target != "" && u.State == "initial" {
+				u.State = "translated"
+			}
+			if n.NotesGroup != nil {
+				for _, note := range n.NotesGroup.Notes {
+					u.Notes = append(u.Notes, note.Text)
+				}
+			}
+			if group != nil {
+				u.Group = group.ID
+				u.GroupName = group.Name
+			}
+			units = append(units, u)
+		}
+	}
+	return units, nil
+}
+
+// The function matches the XLIFF units to the resource strings and converts them into
+// translation updates for the language. The units are matched by their names (the keys)
+// and IDs (the hashes of the strings). The current translations (if given) are used
+// to skip the unchanged units and to detect the overwrites of reviewed translations.
+func MatchXLIFF(doc XLIFFDocument, strs []ResourceString, trs []ResourceTranslation, lang Language) XLIFFImportReport {
+	var report XLIFFImportReport
+
+	// Index the resource strings and the translations
+	byKey := map[string]*ResourceString{}
+	byID := map[string]*ResourceString{}
+	for i := range strs {
+		s := &strs[i]
+		byKey[s.Attributes.Key] = s
+		byID[xliffUnitID(*s)] = s
+	}
+	translations := map[string]ResourceTranslation{}
+	for _, tr := range trs {
+		translations[tr.Relationships.ResourceString.Data.ID] = tr
+	}
+
+	// Combine the plural forms of the grouped units
+	type item struct {
+		units   []XLIFFUnit
+		strings PluralStrings
+		source  string
+		plural  bool
+	}
+	var items []*item
+	groups := map[string]*item{}
+	for _, u := range doc.Units {
+		if u.Group == "" {
+			it := &item{units: []XLIFFUnit{u}, source: u.Source}
+			it.strings.Other = u.Target
+			items = append(items, it)
+			continue
+		}
+		it, ok := groups[u.Group]
+		if !ok {
+			it = &item{plural: true}
+			groups[u.Group] = it
+			items = append(items, it)
+		}
+		it.units = append(it.units, u)
+		it.strings.Set(u.Name, u.Target)
+		if u.Name == "other" {
+			it.source = u.Source
+		}
+	}
+
+	matched := map[string]bool{}
+	for _, it := range items {
+		u := it.units[0]
+
+		// Find the resource string
+		name, id := u.Name, u.ID
+		if it.plural {
+			name, id = u.GroupName, u.Group
+		}
+		s := byKey[name]
+		if s == nil {
+			s = byID[id]
+		}
+		if s == nil {
+			report.Unmatched = append(report.Unmatched, XLIFFUnitIssue{u, "", "no resource string with the name or the ID of the unit"})
+			continue
+		}
+
+		conflict := func(reason string) {
+			report.Conflicts = append(report.Conflicts, XLIFFUnitIssue{u, s.ID, reason})
+		}
+		if matched[s.ID] {
+			conflict("the resource string is matched by several units")
+			continue
+		}
+		matched[s.ID] = true
+
+		if invalid := xliffInvalidUnit(it.units); invalid != "" {
+			conflict(invalid)
+			continue
+		}
+		if it.plural != s.Attributes.Pluralized {
+			conflict("the plural mode differs from the resource string")
+			continue
+		}
+		if it.source != s.Attributes.Strings.Other {
+			conflict("the source text has changed")
+			continue
+		}
+
+		// Skip the untranslated units
+		if it.strings.Other == "" && len(it.strings.Categories()) == 0 {
+			report.Untranslated++
+			continue
+		}
+		if it.plural {
+			if missing := it.strings.MissingForms(lang); len(missing) > 0 {
+				conflict(fmt.Sprintf("the plural forms %v are missed", missing))
+				continue
+			}
+		}
+
+		// Compare with the current translation
+		if tr, ok := translations[s.ID]; ok {
+			if tr.Attributes.Strings == it.strings {
+				report.Unchanged++
+				continue
+			}
+			if tr.Attributes.Reviewed && tr.Attributes.Strings.Other != "" {
+				conflict("the unit overwrites a reviewed translation")
+				continue
+			}
+		}
+
+		// The least advanced state of the units defines the state of the translation
+		reviewed, proofread := true, true
+		for _, unit := range it.units {
+			reviewed = reviewed && (unit.State == "reviewed" || unit.State == "final")
+			proofread = proofread && unit.State == "final"
+		}
+
+		report.Updates = append(report.Updates, TranslationUpdate{
+			ResourceString: s.ID,
+			Key:            s.Attributes.Key,
+			Language:       lang.ID,
+			Strings:        it.strings,
+			Reviewed:       reviewed,
+			Proofread:      proofread,
+		})
+	}
+
+	return report
+}
+
+// The function returns the ID of the unit of a resource string
+func xliffUnitID(s ResourceString) string {
+	if s.Attributes.StringHash != "" {
+		return s.Attributes.StringHash
+	}
+	return xliffToken(s.ID)
+}
+
+// The function converts a string into a valid XML name token
+func xliffToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '.', r == '-', r == '_', r == ':':
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// The function returns the XLIFF 2.0 state of a translation
+func xliffState(target string, tr ResourceTranslation) string {
+	switch {
+	case target == "":
+		return "initial"
+	case tr.Attributes.Proofread:
+		return "final"
+	case tr.Attributes.Reviewed:
+		return "reviewed"
+	default:
+		return "translated"
+	}
+}
+
+// The function converts an XLIFF 2.0 state into the XLIFF 1.2 target state
+func xliff12State(state string) string {
+	switch state {
+	case "final":
+		return "final"
+	case "reviewed":
+		return "signed-off"
+	case "translated":
+		return "translated"
+	default:
+		return "new"
+	}
+}
+
+// The function converts an XLIFF 1.2 target state into the XLIFF 2.0 state
+func xliffNormalizeState(state, target string) string {
+	switch state {
+	case "final":
+		return "final"
+	case "signed-off":
+		return "reviewed"
+	case "new", "needs-translation":
+		if target == "" {
+			return "initial"
+		}
+		return "translated"
+	default:
+		if target == "" {
+			return "initial"
+		}
+		return "translated"
+	}
+}
+
+// The function escapes the text for the inner XML of a source or a target element
+func xliffEscape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return strings.ReplaceAll(b.String(), "&#xA;", "\n")
+}
+
+// The function returns the reason, why one of the units cannot be imported (if any)
+func xliffInvalidUnit(units []XLIFFUnit) string {
+	for _, u := range units {
+		if u.invalid != "" {
+			return u.invalid
+		}
+	}
+	return ""
+}
+
+// The function converts the inner XML of a source or a target element of the unit
+// into the plain text. The unit is marked as invalid, if the conversion fails.
+func (u *XLIFFUnit) plainText(inner string) string {
+	text, err := xliffPlainText(inner)
+	if err != nil && u.invalid == "" {
+		u.invalid = err.Error()
+	}
+	return text
+}
+
+// The function extracts the plain text from the inner XML of a source or a target element.
+// The inline markup elements (e.g. <g>, <pc>, <mrk>) are dropped, their text content is kept.
+// The inline codes are replaced with their textual equivalent or their content (the native code);
+// an error is returned for a code without both of them, as its text would be lost.
+func xliffPlainText(inner string) (string, error) {
+	dec := xml.NewDecoder(strings.NewReader("<root>" + inner + "</root>"))
+	var b strings.Builder
+
+	// The inline code being read: its name, depth and the text length at its start
+	code, codeDepth, codeStart := "", 0, 0
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return b.String(), err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if code != "" || !xliffInlineCodes[tok.Name.Local] {
+				continue
+			}
+			if equiv := xliffEquivText(tok); equiv != "" {
+				b.WriteString(equiv)
+				if err := dec.Skip(); err != nil {
+					return b.String(), err
+				}
+				depth--
+				continue
+			}
+			code, codeDepth, codeStart = tok.Name.Local, depth, b.Len()
+
+		case xml.EndElement:
+			if code != "" && depth == codeDepth {
+				if b.Len() == codeStart {
+					return b.String(), fmt.Errorf("the inline code <%s> has no textual equivalent", code)
+				}
+				code = ""
+			}
+			depth--
+
+		case xml.CharData:
+			b.Write(tok)
+		}
+	}
+	return b.String(), nil
+}
+
+// The function returns the textual equivalent of an inline code element
+func xliffEquivText(el xml.StartElement) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == "equiv-text" || a.Name.Local == "equiv" {
+			return a.Value
+		}
+	}
+	return ""
+}